@@ -25,6 +25,22 @@ const (
 	BankingService_CreateAccount_FullMethodName         = "/banking.BankingService/CreateAccount"
 	BankingService_ListAccount_FullMethodName           = "/banking.BankingService/ListAccount"
 	BankingService_GetTransactionDetails_FullMethodName = "/banking.BankingService/GetTransactionDetails"
+	BankingService_ListTransactions_FullMethodName      = "/banking.BankingService/ListTransactions"
+	BankingService_WatchAccount_FullMethodName          = "/banking.BankingService/WatchAccount"
+	BankingService_BatchTransfer_FullMethodName         = "/banking.BankingService/BatchTransfer"
+	BankingService_PostJournalEntry_FullMethodName      = "/banking.BankingService/PostJournalEntry"
+	BankingService_ListJournalEntries_FullMethodName    = "/banking.BankingService/ListJournalEntries"
+	BankingService_ReverseTransaction_FullMethodName    = "/banking.BankingService/ReverseTransaction"
+	BankingService_FreezeAccount_FullMethodName         = "/banking.BankingService/FreezeAccount"
+	BankingService_UnfreezeAccount_FullMethodName       = "/banking.BankingService/UnfreezeAccount"
+	BankingService_CloseAccount_FullMethodName          = "/banking.BankingService/CloseAccount"
+	BankingService_UpdateAccount_FullMethodName         = "/banking.BankingService/UpdateAccount"
+	BankingService_AuthorizeTransfer_FullMethodName     = "/banking.BankingService/AuthorizeTransfer"
+	BankingService_CaptureTransfer_FullMethodName       = "/banking.BankingService/CaptureTransfer"
+	BankingService_VoidTransfer_FullMethodName          = "/banking.BankingService/VoidTransfer"
+	BankingService_ScheduleTransfer_FullMethodName      = "/banking.BankingService/ScheduleTransfer"
+	BankingService_ListSchedules_FullMethodName         = "/banking.BankingService/ListSchedules"
+	BankingService_CancelSchedule_FullMethodName        = "/banking.BankingService/CancelSchedule"
 )
 
 // BankingServiceClient is the client API for BankingService service.
@@ -37,6 +53,22 @@ type BankingServiceClient interface {
 	CreateAccount(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*AccountResponse, error)
 	ListAccount(ctx context.Context, in *ListAccountRequest, opts ...grpc.CallOption) (*ListAccountResponse, error)
 	GetTransactionDetails(ctx context.Context, in *TransactionDetailsRequest, opts ...grpc.CallOption) (*TransactionDetailsResponse, error)
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	WatchAccount(ctx context.Context, in *WatchAccountRequest, opts ...grpc.CallOption) (BankingService_WatchAccountClient, error)
+	BatchTransfer(ctx context.Context, opts ...grpc.CallOption) (BankingService_BatchTransferClient, error)
+	PostJournalEntry(ctx context.Context, in *JournalEntryRequest, opts ...grpc.CallOption) (*JournalEntryResponse, error)
+	ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error)
+	ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	FreezeAccount(ctx context.Context, in *AccountStatusRequest, opts ...grpc.CallOption) (*AccountStatusResponse, error)
+	UnfreezeAccount(ctx context.Context, in *AccountStatusRequest, opts ...grpc.CallOption) (*AccountStatusResponse, error)
+	CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*AccountStatusResponse, error)
+	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error)
+	AuthorizeTransfer(ctx context.Context, in *AuthorizeTransferRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	CaptureTransfer(ctx context.Context, in *CaptureTransferRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	VoidTransfer(ctx context.Context, in *VoidTransferRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	ScheduleTransfer(ctx context.Context, in *ScheduleTransferRequest, opts ...grpc.CallOption) (*ScheduleResponse, error)
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	CancelSchedule(ctx context.Context, in *CancelScheduleRequest, opts ...grpc.CallOption) (*ScheduleResponse, error)
 }
 
 type bankingServiceClient struct {
@@ -101,6 +133,195 @@ func (c *bankingServiceClient) GetTransactionDetails(ctx context.Context, in *Tr
 	return out, nil
 }
 
+func (c *bankingServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, BankingService_ListTransactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankingServiceClient) WatchAccount(ctx context.Context, in *WatchAccountRequest, opts ...grpc.CallOption) (BankingService_WatchAccountClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BankingService_ServiceDesc.Streams[0], BankingService_WatchAccount_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bankingServiceWatchAccountClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BankingService_WatchAccountClient interface {
+	Recv() (*AccountEvent, error)
+	grpc.ClientStream
+}
+
+type bankingServiceWatchAccountClient struct {
+	grpc.ClientStream
+}
+
+func (x *bankingServiceWatchAccountClient) Recv() (*AccountEvent, error) {
+	m := new(AccountEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bankingServiceClient) BatchTransfer(ctx context.Context, opts ...grpc.CallOption) (BankingService_BatchTransferClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BankingService_ServiceDesc.Streams[1], BankingService_BatchTransfer_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bankingServiceBatchTransferClient{stream}
+	return x, nil
+}
+
+type BankingService_BatchTransferClient interface {
+	Send(*BatchTransferRequest) error
+	Recv() (*BatchTransferResponse, error)
+	grpc.ClientStream
+}
+
+type bankingServiceBatchTransferClient struct {
+	grpc.ClientStream
+}
+
+func (x *bankingServiceBatchTransferClient) Send(m *BatchTransferRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *bankingServiceBatchTransferClient) Recv() (*BatchTransferResponse, error) {
+	m := new(BatchTransferResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bankingServiceClient) PostJournalEntry(ctx context.Context, in *JournalEntryRequest, opts ...grpc.CallOption) (*JournalEntryResponse, error) {
+	out := new(JournalEntryResponse)
+	err := c.cc.Invoke(ctx, BankingService_PostJournalEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankingServiceClient) ListJournalEntries(ctx context.Context, in *ListJournalEntriesRequest, opts ...grpc.CallOption) (*ListJournalEntriesResponse, error) {
+	out := new(ListJournalEntriesResponse)
+	err := c.cc.Invoke(ctx, BankingService_ListJournalEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankingServiceClient) ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, BankingService_ReverseTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankingServiceClient) FreezeAccount(ctx context.Context, in *AccountStatusRequest, opts ...grpc.CallOption) (*AccountStatusResponse, error) {
+	out := new(AccountStatusResponse)
+	err := c.cc.Invoke(ctx, BankingService_FreezeAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankingServiceClient) UnfreezeAccount(ctx context.Context, in *AccountStatusRequest, opts ...grpc.CallOption) (*AccountStatusResponse, error) {
+	out := new(AccountStatusResponse)
+	err := c.cc.Invoke(ctx, BankingService_UnfreezeAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankingServiceClient) CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*AccountStatusResponse, error) {
+	out := new(AccountStatusResponse)
+	err := c.cc.Invoke(ctx, BankingService_CloseAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankingServiceClient) UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error) {
+	out := new(UpdateAccountResponse)
+	err := c.cc.Invoke(ctx, BankingService_UpdateAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankingServiceClient) AuthorizeTransfer(ctx context.Context, in *AuthorizeTransferRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, BankingService_AuthorizeTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankingServiceClient) CaptureTransfer(ctx context.Context, in *CaptureTransferRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, BankingService_CaptureTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankingServiceClient) VoidTransfer(ctx context.Context, in *VoidTransferRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, BankingService_VoidTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankingServiceClient) ScheduleTransfer(ctx context.Context, in *ScheduleTransferRequest, opts ...grpc.CallOption) (*ScheduleResponse, error) {
+	out := new(ScheduleResponse)
+	err := c.cc.Invoke(ctx, BankingService_ScheduleTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankingServiceClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, BankingService_ListSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankingServiceClient) CancelSchedule(ctx context.Context, in *CancelScheduleRequest, opts ...grpc.CallOption) (*ScheduleResponse, error) {
+	out := new(ScheduleResponse)
+	err := c.cc.Invoke(ctx, BankingService_CancelSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BankingServiceServer is the server API for BankingService service.
 // All implementations must embed UnimplementedBankingServiceServer
 // for forward compatibility
@@ -111,6 +332,22 @@ type BankingServiceServer interface {
 	CreateAccount(context.Context, *AccountRequest) (*AccountResponse, error)
 	ListAccount(context.Context, *ListAccountRequest) (*ListAccountResponse, error)
 	GetTransactionDetails(context.Context, *TransactionDetailsRequest) (*TransactionDetailsResponse, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	WatchAccount(*WatchAccountRequest, BankingService_WatchAccountServer) error
+	BatchTransfer(BankingService_BatchTransferServer) error
+	PostJournalEntry(context.Context, *JournalEntryRequest) (*JournalEntryResponse, error)
+	ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error)
+	ReverseTransaction(context.Context, *ReverseTransactionRequest) (*TransactionResponse, error)
+	FreezeAccount(context.Context, *AccountStatusRequest) (*AccountStatusResponse, error)
+	UnfreezeAccount(context.Context, *AccountStatusRequest) (*AccountStatusResponse, error)
+	CloseAccount(context.Context, *CloseAccountRequest) (*AccountStatusResponse, error)
+	UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error)
+	AuthorizeTransfer(context.Context, *AuthorizeTransferRequest) (*TransactionResponse, error)
+	CaptureTransfer(context.Context, *CaptureTransferRequest) (*TransactionResponse, error)
+	VoidTransfer(context.Context, *VoidTransferRequest) (*TransactionResponse, error)
+	ScheduleTransfer(context.Context, *ScheduleTransferRequest) (*ScheduleResponse, error)
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	CancelSchedule(context.Context, *CancelScheduleRequest) (*ScheduleResponse, error)
 	mustEmbedUnimplementedBankingServiceServer()
 }
 
@@ -136,6 +373,54 @@ func (UnimplementedBankingServiceServer) ListAccount(context.Context, *ListAccou
 func (UnimplementedBankingServiceServer) GetTransactionDetails(context.Context, *TransactionDetailsRequest) (*TransactionDetailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionDetails not implemented")
 }
+func (UnimplementedBankingServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedBankingServiceServer) WatchAccount(*WatchAccountRequest, BankingService_WatchAccountServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAccount not implemented")
+}
+func (UnimplementedBankingServiceServer) BatchTransfer(BankingService_BatchTransferServer) error {
+	return status.Errorf(codes.Unimplemented, "method BatchTransfer not implemented")
+}
+func (UnimplementedBankingServiceServer) PostJournalEntry(context.Context, *JournalEntryRequest) (*JournalEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostJournalEntry not implemented")
+}
+func (UnimplementedBankingServiceServer) ListJournalEntries(context.Context, *ListJournalEntriesRequest) (*ListJournalEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJournalEntries not implemented")
+}
+func (UnimplementedBankingServiceServer) ReverseTransaction(context.Context, *ReverseTransactionRequest) (*TransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseTransaction not implemented")
+}
+func (UnimplementedBankingServiceServer) FreezeAccount(context.Context, *AccountStatusRequest) (*AccountStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAccount not implemented")
+}
+func (UnimplementedBankingServiceServer) UnfreezeAccount(context.Context, *AccountStatusRequest) (*AccountStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeAccount not implemented")
+}
+func (UnimplementedBankingServiceServer) CloseAccount(context.Context, *CloseAccountRequest) (*AccountStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAccount not implemented")
+}
+func (UnimplementedBankingServiceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
+}
+func (UnimplementedBankingServiceServer) AuthorizeTransfer(context.Context, *AuthorizeTransferRequest) (*TransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeTransfer not implemented")
+}
+func (UnimplementedBankingServiceServer) CaptureTransfer(context.Context, *CaptureTransferRequest) (*TransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureTransfer not implemented")
+}
+func (UnimplementedBankingServiceServer) VoidTransfer(context.Context, *VoidTransferRequest) (*TransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidTransfer not implemented")
+}
+func (UnimplementedBankingServiceServer) ScheduleTransfer(context.Context, *ScheduleTransferRequest) (*ScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleTransfer not implemented")
+}
+func (UnimplementedBankingServiceServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedBankingServiceServer) CancelSchedule(context.Context, *CancelScheduleRequest) (*ScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSchedule not implemented")
+}
 func (UnimplementedBankingServiceServer) mustEmbedUnimplementedBankingServiceServer() {}
 
 // UnsafeBankingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -257,6 +542,305 @@ func _BankingService_GetTransactionDetails_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BankingService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankingServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankingService_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankingServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankingService_WatchAccount_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAccountRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BankingServiceServer).WatchAccount(m, &bankingServiceWatchAccountServer{stream})
+}
+
+type BankingService_WatchAccountServer interface {
+	Send(*AccountEvent) error
+	grpc.ServerStream
+}
+
+type bankingServiceWatchAccountServer struct {
+	grpc.ServerStream
+}
+
+func (x *bankingServiceWatchAccountServer) Send(m *AccountEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BankingService_BatchTransfer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BankingServiceServer).BatchTransfer(&bankingServiceBatchTransferServer{stream})
+}
+
+type BankingService_BatchTransferServer interface {
+	Send(*BatchTransferResponse) error
+	Recv() (*BatchTransferRequest, error)
+	grpc.ServerStream
+}
+
+type bankingServiceBatchTransferServer struct {
+	grpc.ServerStream
+}
+
+func (x *bankingServiceBatchTransferServer) Send(m *BatchTransferResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *bankingServiceBatchTransferServer) Recv() (*BatchTransferRequest, error) {
+	m := new(BatchTransferRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BankingService_PostJournalEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JournalEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankingServiceServer).PostJournalEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankingService_PostJournalEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankingServiceServer).PostJournalEntry(ctx, req.(*JournalEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankingService_ListJournalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJournalEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankingServiceServer).ListJournalEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankingService_ListJournalEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankingServiceServer).ListJournalEntries(ctx, req.(*ListJournalEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankingService_ReverseTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankingServiceServer).ReverseTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankingService_ReverseTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankingServiceServer).ReverseTransaction(ctx, req.(*ReverseTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankingService_FreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankingServiceServer).FreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankingService_FreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankingServiceServer).FreezeAccount(ctx, req.(*AccountStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankingService_UnfreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankingServiceServer).UnfreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankingService_UnfreezeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankingServiceServer).UnfreezeAccount(ctx, req.(*AccountStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankingService_CloseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankingServiceServer).CloseAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankingService_CloseAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankingServiceServer).CloseAccount(ctx, req.(*CloseAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankingService_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankingServiceServer).UpdateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankingService_UpdateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankingServiceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankingService_AuthorizeTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankingServiceServer).AuthorizeTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankingService_AuthorizeTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankingServiceServer).AuthorizeTransfer(ctx, req.(*AuthorizeTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankingService_CaptureTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankingServiceServer).CaptureTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankingService_CaptureTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankingServiceServer).CaptureTransfer(ctx, req.(*CaptureTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankingService_VoidTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankingServiceServer).VoidTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankingService_VoidTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankingServiceServer).VoidTransfer(ctx, req.(*VoidTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankingService_ScheduleTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankingServiceServer).ScheduleTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankingService_ScheduleTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankingServiceServer).ScheduleTransfer(ctx, req.(*ScheduleTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankingService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankingServiceServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankingService_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankingServiceServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankingService_CancelSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankingServiceServer).CancelSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BankingService_CancelSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankingServiceServer).CancelSchedule(ctx, req.(*CancelScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BankingService_ServiceDesc is the grpc.ServiceDesc for BankingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -288,7 +872,75 @@ var BankingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactionDetails",
 			Handler:    _BankingService_GetTransactionDetails_Handler,
 		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _BankingService_ListTransactions_Handler,
+		},
+		{
+			MethodName: "PostJournalEntry",
+			Handler:    _BankingService_PostJournalEntry_Handler,
+		},
+		{
+			MethodName: "ListJournalEntries",
+			Handler:    _BankingService_ListJournalEntries_Handler,
+		},
+		{
+			MethodName: "ReverseTransaction",
+			Handler:    _BankingService_ReverseTransaction_Handler,
+		},
+		{
+			MethodName: "FreezeAccount",
+			Handler:    _BankingService_FreezeAccount_Handler,
+		},
+		{
+			MethodName: "UnfreezeAccount",
+			Handler:    _BankingService_UnfreezeAccount_Handler,
+		},
+		{
+			MethodName: "CloseAccount",
+			Handler:    _BankingService_CloseAccount_Handler,
+		},
+		{
+			MethodName: "UpdateAccount",
+			Handler:    _BankingService_UpdateAccount_Handler,
+		},
+		{
+			MethodName: "AuthorizeTransfer",
+			Handler:    _BankingService_AuthorizeTransfer_Handler,
+		},
+		{
+			MethodName: "CaptureTransfer",
+			Handler:    _BankingService_CaptureTransfer_Handler,
+		},
+		{
+			MethodName: "VoidTransfer",
+			Handler:    _BankingService_VoidTransfer_Handler,
+		},
+		{
+			MethodName: "ScheduleTransfer",
+			Handler:    _BankingService_ScheduleTransfer_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _BankingService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "CancelSchedule",
+			Handler:    _BankingService_CancelSchedule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAccount",
+			Handler:       _BankingService_WatchAccount_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BatchTransfer",
+			Handler:       _BankingService_BatchTransfer_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/banking.proto",
 }
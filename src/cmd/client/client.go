@@ -2,17 +2,51 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io"
 	"log"
 	"os"
 	"strconv"
 	"time"
 
 	pb "github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/bryanvaz/grpc-gl/src/telemetry"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
+	addr := flag.String("addr", "localhost:50051", "server host:port")
+	useTLS := flag.Bool("tls", false, "connect with TLS, trusting the system CAs unless -tls-ca is set")
+	caFile := flag.String("tls-ca", "", "PEM CA certificates to trust; implies -tls")
+	certFile := flag.String("tls-cert", "", "PEM client certificate for mutual TLS; implies -tls")
+	keyFile := flag.String("tls-key", "", "PEM private key of -tls-cert")
+	serverName := flag.String("tls-server-name", "", "name to verify the server certificate against, if not the host of -addr")
+	token := flag.String("token", "", "bearer token (API key or JWT) sent with every call; requires TLS (default $BANKING_TOKEN)")
+	var tracing telemetry.TracingConfig
+	flag.StringVar(&tracing.Exporter, "trace-exporter", "none", "where to send a span for every call: none, otlp, stdout or file")
+	flag.StringVar(&tracing.Endpoint, "trace-endpoint", "http://localhost:4317", "OTLP gRPC collector URL for -trace-exporter otlp")
+	flag.StringVar(&tracing.File, "trace-file", "", "file spans are appended to for -trace-exporter file")
+	flag.Float64Var(&tracing.SampleRatio, "trace-sample-ratio", 1, "fraction of calls to trace")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <create|balance|plow|watch|transfers> [args]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	// Read here rather than as the flag default, which -h would print.
+	if *token == "" {
+		*token = os.Getenv("BANKING_TOKEN")
+	}
+	// Commands read their own arguments from os.Args.
+	os.Args = append(os.Args[:1], flag.Args()...)
 	args := os.Args[1:]
 
 	if len(args) == 0 {
@@ -20,10 +54,34 @@ func main() {
 	}
 	action := args[0]
 
+	shutdown, err := telemetry.SetupTracing(context.Background(), "banking-client", tracing)
+	if err != nil {
+		log.Fatalf("Failed to set up tracing: %v", err)
+	}
+	shutdownTracing = shutdown
+	defer flushSpans()
+
+	creds := insecure.NewCredentials()
+	if *useTLS || *caFile != "" || *certFile != "" {
+		tlsConfig, err := clientTLSConfig(*caFile, *certFile, *keyFile, *serverName)
+		if err != nil {
+			log.Fatalf("Failed to load TLS configuration: %v", err)
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
+	if *token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken(*token)))
+	}
+
 	// Set up a connection to the server
-	conn, err := grpc.Dial("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(*addr, opts...)
 	if err != nil {
-		log.Fatalf("Failed to connect: %v", err)
+		fatalf("Failed to connect: %v", err)
 	}
 	defer conn.Close()
 
@@ -37,11 +95,78 @@ func main() {
 		getBalance(client)
 	case "plow":
 		plow(client)
+	case "watch":
+		watchAccounts(client)
+	case "transfers":
+		benchTransfers(client)
 	default:
-		log.Fatalf("Invalid command provided")
+		fatalf("Invalid command provided")
+	}
+}
+
+var (
+	tracer          = otel.Tracer("github.com/bryanvaz/grpc-gl/src/cmd/client")
+	shutdownTracing = func(context.Context) error { return nil }
+)
+
+// startCall starts the root span of a call, so that each call made by a
+// command can be followed through the server on its own.
+func startCall(name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(context.Background(), name, trace.WithNewRoot(), trace.WithAttributes(attrs...))
+}
+
+// flushSpans sends any spans not yet exported.
+func flushSpans() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("Failed to flush spans: %v", err)
 	}
 }
 
+// fatalf is log.Fatalf for commands, flushing spans first so that the
+// trace of the failed call is not lost.
+func fatalf(format string, v ...any) {
+	flushSpans()
+	log.Fatalf(format, v...)
+}
+
+// clientTLSConfig trusts the CAs in caFile, or the system CAs when it is
+// empty, and presents the client certificate in certFile if it is set.
+func clientTLSConfig(caFile, certFile, keyFile, serverName string) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: serverName}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", caFile)
+		}
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+// bearerToken sends a token in the authorization header of every call.
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity keeps tokens off plaintext connections.
+func (t bearerToken) RequireTransportSecurity() bool {
+	return true
+}
+
 func plow(c pb.BankingServiceClient) {
 	numIter := 1
 	numConns := 1
@@ -71,11 +196,12 @@ func plow(c pb.BankingServiceClient) {
 	for i := 0; i < numConns; i++ {
 		go func() {
 			for j := range jobs {
-				_ = j
 				start := time.Now().UnixMicro()
-				_, err := c.Ping(context.Background(), pr)
+				ctx, span := startCall("plow.Ping", attribute.Int("iteration", j))
+				_, err := c.Ping(ctx, pr)
+				span.End()
 				if err != nil {
-					log.Fatalf("Failed to ping: %v", err)
+					fatalf("Failed to ping: %v", err)
 				}
 				end := time.Now().UnixMicro()
 				results <- end - start
@@ -118,11 +244,13 @@ func createAccount(c pb.BankingServiceClient) {
 	// fmt.Println(args)
 	// Call the CreateAccount method
 	ar := &pb.AccountRequest{
-		InitialBalance: 50000.0,
+		InitialBalance: &pb.Money{CurrencyCode: "USD", Units: 50000},
 	}
-	createAccountResponse, err := c.CreateAccount(context.Background(), ar)
+	ctx, span := startCall("create")
+	createAccountResponse, err := c.CreateAccount(ctx, ar)
+	span.End()
 	if err != nil {
-		log.Fatalf("Failed to create account: %v", err)
+		fatalf("Failed to create account: %v", err)
 	}
 	log.Printf("Response from server: %v", createAccountResponse)
 }
@@ -131,15 +259,138 @@ func getBalance(c pb.BankingServiceClient) {
 	// Read CLI arguments into a string array
 	args := os.Args[2:]
 	if len(args) == 0 {
-		log.Fatalf("No account ID provided")
+		fatalf("No account ID provided")
 	}
 	accountID := args[0]
 	getBalanceRequest := &pb.BalanceRequest{
 		AccountId: accountID,
 	}
-	getBalanceResponse, err := c.GetBalance(context.Background(), getBalanceRequest)
+	ctx, span := startCall("balance")
+	getBalanceResponse, err := c.GetBalance(ctx, getBalanceRequest)
+	span.End()
 	if err != nil {
-		log.Fatalf("Failed to get balance: %v", err)
+		fatalf("Failed to get balance: %v", err)
 	}
 	log.Printf("Balance for account %s: %v", accountID, getBalanceResponse.Balance)
 }
+
+func watchAccounts(c pb.BankingServiceClient) {
+	accountIDs := os.Args[2:]
+	if len(accountIDs) == 0 {
+		fatalf("No account ID provided")
+	}
+	ctx, span := startCall("watch")
+	stream, err := c.WatchAccount(ctx, &pb.WatchAccountRequest{AccountIds: accountIDs})
+	if err != nil {
+		span.End()
+		fatalf("Failed to watch accounts: %v", err)
+	}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			span.End()
+			fatalf("Watch ended: %v", err)
+		}
+		cause := "transaction " + event.GetTransaction().GetTransactionId()
+		if entry := event.GetJournalEntry(); entry != nil {
+			cause = "journal entry " + entry.GetEntryId()
+		}
+		log.Printf("#%d Balance for account %s: %v (%s)",
+			event.Sequence, event.AccountId, event.Balance, cause)
+	}
+}
+
+// benchTransfers posts transfers of 0.01 USD between two accounts and
+// reports the throughput, either with unary MakeTransaction calls or, with
+// -stream, over BatchTransfer streams.
+func benchTransfers(c pb.BankingServiceClient) {
+	if len(os.Args) < 4 {
+		fatalf("Usage: transfers <from account ID> <to account ID> [-n count] [-c connections] [-stream]")
+	}
+	from, to := os.Args[2], os.Args[3]
+	numIter := 1
+	numConns := 1
+	streaming := false
+	for pos, value := range os.Args {
+		switch {
+		case value == "-n" && pos < len(os.Args)-1:
+			numIter, _ = strconv.Atoi(os.Args[pos+1])
+		case value == "-c" && pos < len(os.Args)-1:
+			numConns, _ = strconv.Atoi(os.Args[pos+1])
+		case value == "-stream":
+			streaming = true
+		}
+	}
+
+	req := &pb.TransactionRequest{
+		FromAccountId: from,
+		ToAccountId:   to,
+		Amount:        &pb.Money{CurrencyCode: "USD", Nanos: 10_000_000},
+	}
+	failures := make(chan int, numConns)
+	start := time.Now()
+	for i := 0; i < numConns; i++ {
+		count := numIter / numConns
+		if i < numIter%numConns {
+			count++
+		}
+		go func() {
+			if streaming {
+				failures <- streamTransfers(c, req, count)
+			} else {
+				failures <- unaryTransfers(c, req, count)
+			}
+		}()
+	}
+	failed := 0
+	for i := 0; i < numConns; i++ {
+		failed += <-failures
+	}
+	elapsed := time.Since(start)
+	log.Printf(
+		"%d transfers (%d failed) in %.1f sec - RPS: %.1f",
+		numIter, failed, elapsed.Seconds(), float64(numIter)/elapsed.Seconds(),
+	)
+}
+
+func unaryTransfers(c pb.BankingServiceClient, req *pb.TransactionRequest, count int) int {
+	failed := 0
+	for i := 0; i < count; i++ {
+		ctx, span := startCall("transfers.MakeTransaction", attribute.Int("iteration", i))
+		if _, err := c.MakeTransaction(ctx, req); err != nil {
+			failed++
+		}
+		span.End()
+	}
+	return failed
+}
+
+func streamTransfers(c pb.BankingServiceClient, req *pb.TransactionRequest, count int) int {
+	ctx, span := startCall("transfers.BatchTransfer", attribute.Int("count", count))
+	defer span.End()
+	stream, err := c.BatchTransfer(ctx)
+	if err != nil {
+		fatalf("Failed to open stream: %v", err)
+	}
+	go func() {
+		for i := 0; i < count; i++ {
+			if err := stream.Send(&pb.BatchTransferRequest{Sequence: uint64(i), Transaction: req}); err != nil {
+				fatalf("Failed to send transfer: %v", err)
+			}
+		}
+		stream.CloseSend()
+	}()
+	failed := 0
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return failed
+		}
+		if err != nil {
+			fatalf("Stream failed: %v", err)
+		}
+		if res.GetError() != nil {
+			failed++
+		}
+	}
+}
@@ -1,35 +1,57 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
 	"os"
 	"os/signal"
-	"sync"
 	"syscall"
+	"time"
 
-	"github.com/bryanvaz/grpc-gl/protos/go/banking"
 	GrpcServer "github.com/bryanvaz/grpc-gl/src/server"
+	"github.com/bryanvaz/grpc-gl/src/telemetry"
 )
 
-var accounts = make(map[string]int32)
-var transactions = make(map[string]*banking.Transaction)
-var mtx sync.Mutex
+func main() {
+	cfg, err := GrpcServer.LoadConfig(os.Args[1:], os.LookupEnv)
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		slog.New(slog.NewJSONHandler(os.Stderr, nil)).Error("Invalid configuration", "error", err)
+		os.Exit(1)
+	}
 
-var DEBUG = true
+	s, err := cfg.NewServer()
+	if err != nil {
+		slog.New(slog.NewJSONHandler(os.Stderr, nil)).Error("Failed to configure server", "error", err)
+		os.Exit(1)
+	}
+	// Everything else in the process logs the same way as the server.
+	slog.SetDefault(s.Logger)
+	slog.Info("Effective configuration", "config", cfg)
 
-func main() {
-	s := GrpcServer.NewServer()
+	shutdownTracing, err := telemetry.SetupTracing(context.Background(), "banking-server", cfg.Tracing)
+	if err != nil {
+		slog.Error("Failed to set up tracing", "error", err)
+		os.Exit(1)
+	}
 
 	// Start serving incoming connections
 	go func() {
 		if err := s.Start(); err != nil {
-			log.Fatalf("Failed to serve: %v", err)
+			slog.Error("Failed to serve", "error", err)
+			os.Exit(1)
 		}
 	}()
 
 	// Print a console message indicating that the server is running
-	log.Printf("Server started, listening on port %d", s.Port)
-	log.Println("Press Ctrl+C to quit")
+	slog.Info("Server started", "address", s.Address, "metricsAddress", s.MetricsAddress)
+	if s.Authenticator == nil {
+		slog.Warn("Authentication is disabled: any caller may act on any account")
+	}
 
 	// Block until a signal is received
 	signalChan := make(chan os.Signal, 1)
@@ -37,6 +59,13 @@ func main() {
 	<-signalChan
 
 	// Gracefully stop the server
-	log.Println("Shutting down server...")
+	slog.Info("Shutting down server")
 	s.GracefulStop()
+
+	// Send the spans of the last calls before exiting.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("Failed to flush spans", "error", err)
+	}
 }
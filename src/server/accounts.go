@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+)
+
+const maxLabels = 64
+
+var labelKeyPattern = regexp.MustCompile(`^[a-z0-9_-]{1,63}$`)
+
+func accountID(account *banking.Account) string { return account.Id }
+
+var (
+	accountsByCreatedAt = Ordering[*banking.Account]{
+		Name: "createdAt",
+		Key:  func(account *banking.Account) []byte { return timeKey(account.CreatedAt) },
+		ID:   accountID,
+	}
+	accountsByID      = Ordering[*banking.Account]{Name: "id", ID: accountID}
+	accountsByBalance = Ordering[*banking.Account]{
+		Name: "balance",
+		Key:  func(account *banking.Account) []byte { return moneyKey(account.Balance) },
+		ID:   accountID,
+	}
+)
+
+// accountOrderings are the orderBy values accepted by ListAccount.
+var accountOrderings = map[string]Ordering[*banking.Account]{
+	"createdAt": accountsByCreatedAt,
+	"id":        accountsByID,
+	"balance":   accountsByBalance,
+}
+
+// accountOrdering parses orderBy into an ordering of accounts. It returns
+// false if orderBy is not recognised.
+func accountOrdering(orderBy string) (Ordering[*banking.Account], bool) {
+	field, desc := parseOrderBy(orderBy, "createdAt")
+	order, ok := accountOrderings[field]
+	order.Desc = desc
+	return order, ok
+}
+
+// parseOrderBy splits an orderBy value such as "balance desc" into its
+// field and direction.
+func parseOrderBy(orderBy, defaultField string) (string, bool) {
+	parts := strings.Fields(orderBy)
+	switch {
+	case len(parts) == 0:
+		return defaultField, false
+	case len(parts) == 2 && parts[1] == "desc":
+		return parts[0], true
+	case len(parts) == 2 && parts[1] == "asc", len(parts) == 1:
+		return parts[0], false
+	}
+	return "", false
+}
+
+// matchAccount reports whether account matches every filter in req.
+func matchAccount(account *banking.Account, req *banking.ListAccountRequest) bool {
+	switch {
+	case req.MinBalance != nil && (account.Balance.GetCurrencyCode() != req.MinBalance.CurrencyCode ||
+		moneyNanos(account.Balance).Cmp(moneyNanos(req.MinBalance)) < 0):
+		return false
+	case req.MaxBalance != nil && (account.Balance.GetCurrencyCode() != req.MaxBalance.CurrencyCode ||
+		moneyNanos(account.Balance).Cmp(moneyNanos(req.MaxBalance)) > 0):
+		return false
+	case req.CreatedAfter != nil && !account.CreatedAt.AsTime().After(req.CreatedAfter.AsTime()):
+		return false
+	case req.Status != banking.AccountStatus_ACCOUNT_STATUS_UNSPECIFIED && account.Status != req.Status:
+		return false
+	case req.OwnerId != "" && account.OwnerId != req.OwnerId:
+		return false
+	case req.Type != banking.AccountType_ACCOUNT_TYPE_UNSPECIFIED && account.Type != req.Type:
+		return false
+	}
+	return hasLabels(account, req.Labels)
+}
+
+// listAccounts applies the filters, ordering and pagination of req to the
+// accounts in store.
+func listAccounts(ctx context.Context, store Store, req *banking.ListAccountRequest) (*banking.ListAccountResponse, error) {
+	order, ok := accountOrdering(req.OrderBy)
+	if !ok {
+		return nil, invalidArgument(FieldViolation{"orderBy", "must be one of createdAt, id or balance, optionally followed by desc"})
+	}
+	match := func(account *banking.Account) bool { return matchAccount(account, req) }
+
+	page, next, err := listPage(ctx, req, order, match, store.ScanAccounts)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	var total int32
+	err = store.ScanAccounts(ctx, accountsByID, nil, func(account *banking.Account) bool {
+		if match(account) {
+			total++
+		}
+		return true
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &banking.ListAccountResponse{
+		Accounts:      page,
+		NextPageToken: next,
+		TotalSize:     total,
+	}, nil
+}
+
+// hasLabels reports whether account carries every one of labels.
+func hasLabels(account *banking.Account, labels map[string]string) bool {
+	for key, value := range labels {
+		if v, ok := account.Labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+// checkLabels reports labels that break the limits documented on
+// AccountRequest.labels.
+func checkLabels(field string, labels map[string]string) []FieldViolation {
+	var violations []FieldViolation
+	if len(labels) > maxLabels {
+		violations = append(violations, FieldViolation{field, fmt.Sprintf("must have at most %d labels", maxLabels)})
+	}
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		switch {
+		case !labelKeyPattern.MatchString(key):
+			violations = append(violations, FieldViolation{field + "[" + key + "]", "key must be 1 to 63 lower case letters, digits, '-' or '_'"})
+		case len(labels[key]) > 255:
+			violations = append(violations, FieldViolation{field + "[" + key + "]", "must be at most 255 bytes"})
+		}
+	}
+	return violations
+}
@@ -0,0 +1,242 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func createTestAccounts(t *testing.T, s *Server, balances ...int64) []string {
+	ids := make([]string, len(balances))
+	for i, balance := range balances {
+		res, err := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(balance)})
+		require.NoError(t, err)
+		ids[i] = res.AccountId
+	}
+	return ids
+}
+
+func accountIDs(accounts []*banking.Account) []string {
+	ids := make([]string, len(accounts))
+	for i, account := range accounts {
+		ids[i] = account.Id
+	}
+	return ids
+}
+
+func TestListAccount_Pagination(t *testing.T) {
+	s := getNewTestServer()
+	ids := createTestAccounts(t, s, 10, 20, 30, 40, 50)
+
+	req := &banking.ListAccountRequest{PageSize: 2}
+	var seen []string
+	for page := 0; ; page++ {
+		res, err := s.ListAccount(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, int32(5), res.TotalSize)
+		seen = append(seen, accountIDs(res.Accounts)...)
+		if res.NextPageToken == "" {
+			assert.Equal(t, 2, page)
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	assert.Equal(t, ids, seen)
+}
+
+func TestListAccount_PageTokenStableUnderInserts(t *testing.T) {
+	s := getNewTestServer()
+	ids := createTestAccounts(t, s, 10, 20, 30)
+
+	res, err := s.ListAccount(context.Background(), &banking.ListAccountRequest{PageSize: 2})
+	require.NoError(t, err)
+	more := createTestAccounts(t, s, 40)
+
+	res, err = s.ListAccount(context.Background(), &banking.ListAccountRequest{PageSize: 2, PageToken: res.NextPageToken})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[2], more[0]}, accountIDs(res.Accounts))
+}
+
+func TestListAccount_PageTokenHoldsCursorOnly(t *testing.T) {
+	s := getNewTestServer()
+	labels := map[string]string{}
+	for i := 0; i < maxLabels; i++ {
+		labels[fmt.Sprintf("label-%d", i)] = strings.Repeat("x", 255)
+	}
+	for i := 0; i < 2; i++ {
+		_, err := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(10), Labels: labels})
+		require.NoError(t, err)
+	}
+
+	res, err := s.ListAccount(context.Background(), &banking.ListAccountRequest{PageSize: 1})
+	require.NoError(t, err)
+	assert.Less(t, len(res.NextPageToken), 128)
+}
+
+func TestListAccount_PagesByBalance(t *testing.T) {
+	s := getNewTestServer()
+	for i, balance := range []*banking.Money{usd(-20), usd(5), usd(-3), usd(5), usd(300), {CurrencyCode: "EUR", Units: 7}} {
+		require.NoError(t, s.store.CreateAccount(context.Background(), &banking.Account{Id: fmt.Sprint(i), Balance: balance}))
+	}
+
+	for _, orderBy := range []string{"balance", "balance desc"} {
+		all, err := s.ListAccount(context.Background(), &banking.ListAccountRequest{OrderBy: orderBy})
+		require.NoError(t, err)
+		req := &banking.ListAccountRequest{OrderBy: orderBy, PageSize: 1}
+		var seen []string
+		for {
+			res, err := s.ListAccount(context.Background(), req)
+			require.NoError(t, err)
+			seen = append(seen, accountIDs(res.Accounts)...)
+			if res.NextPageToken == "" {
+				break
+			}
+			req.PageToken = res.NextPageToken
+		}
+		assert.Equal(t, accountIDs(all.Accounts), seen, orderBy)
+		if orderBy == "balance" {
+			assert.Equal(t, []string{"5", "0", "2", "1", "3", "4"}, seen)
+		}
+	}
+}
+
+func TestListAccount_FiltersAndOrder(t *testing.T) {
+	s := getNewTestServer()
+	ids := createTestAccounts(t, s, 10, 50, 30)
+	first, _ := s.store.GetAccount(context.Background(), ids[0])
+
+	res, err := s.ListAccount(context.Background(), &banking.ListAccountRequest{
+		MinBalance: usd(20),
+		OrderBy:    "balance desc",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[1], ids[2]}, accountIDs(res.Accounts))
+
+	res, err = s.ListAccount(context.Background(), &banking.ListAccountRequest{
+		MaxBalance:   usd(40),
+		CreatedAfter: first.CreatedAt,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[2]}, accountIDs(res.Accounts))
+
+	res, err = s.ListAccount(context.Background(), &banking.ListAccountRequest{
+		MinBalance: &banking.Money{CurrencyCode: "EUR"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, res.Accounts)
+
+	res, err = s.ListAccount(context.Background(), &banking.ListAccountRequest{
+		CreatedAfter: timestamppb.Now(),
+		Status:       banking.AccountStatus_ACCOUNT_STATUS_ACTIVE,
+	})
+	require.NoError(t, err)
+	assert.Zero(t, res.TotalSize)
+}
+
+func TestListAccount_InvalidRequests(t *testing.T) {
+	s := getNewTestServer()
+	createTestAccounts(t, s, 10, 20, 30)
+
+	_, err := s.ListAccount(context.Background(), &banking.ListAccountRequest{OrderBy: "owner"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.ListAccount(context.Background(), &banking.ListAccountRequest{PageToken: "garbage"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, ReasonInvalidPageToken, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+
+	// A token may not be reused with different filters.
+	res, _ := s.ListAccount(context.Background(), &banking.ListAccountRequest{PageSize: 1})
+	_, err = s.ListAccount(context.Background(), &banking.ListAccountRequest{
+		PageSize:  1,
+		PageToken: res.NextPageToken,
+		OrderBy:   "id",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListAccount_MetadataFilters(t *testing.T) {
+	s := getNewTestServer()
+	var ids []string
+	for _, req := range []*banking.AccountRequest{
+		{OwnerId: "alice", Type: banking.AccountType_ACCOUNT_TYPE_CHECKING, Labels: map[string]string{"team": "payroll"}},
+		{OwnerId: "alice", Type: banking.AccountType_ACCOUNT_TYPE_SAVINGS},
+		{OwnerId: "bob", Type: banking.AccountType_ACCOUNT_TYPE_CHECKING, Labels: map[string]string{"team": "payroll", "tier": "gold"}},
+	} {
+		req.InitialBalance = usd(10)
+		res, err := s.CreateAccount(context.Background(), req)
+		require.NoError(t, err)
+		ids = append(ids, res.AccountId)
+	}
+
+	res, err := s.ListAccount(context.Background(), &banking.ListAccountRequest{OwnerId: "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, ids[:2], accountIDs(res.Accounts))
+	assert.Equal(t, "payroll", res.Accounts[0].Labels["team"])
+	assert.NotNil(t, res.Accounts[0].UpdatedAt)
+
+	res, err = s.ListAccount(context.Background(), &banking.ListAccountRequest{Type: banking.AccountType_ACCOUNT_TYPE_CHECKING})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[0], ids[2]}, accountIDs(res.Accounts))
+
+	res, err = s.ListAccount(context.Background(), &banking.ListAccountRequest{
+		Labels: map[string]string{"team": "payroll", "tier": "gold"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[2]}, accountIDs(res.Accounts))
+}
+
+func TestCreateAccount_InvalidLabels(t *testing.T) {
+	s := getNewTestServer()
+	_, err := s.CreateAccount(context.Background(), &banking.AccountRequest{
+		InitialBalance: usd(10),
+		Labels:         map[string]string{"Bad Key": "x", "ok": "y"},
+	})
+	fields := violatedFields(t, err)
+	assert.Len(t, fields, 1)
+	assert.Contains(t, fields, "labels[Bad Key]")
+}
+
+func TestUpdateAccount(t *testing.T) {
+	s := getNewTestServer()
+	ids := createTestAccounts(t, s, 10)
+	before, _ := s.store.GetAccount(context.Background(), ids[0])
+
+	res, err := s.UpdateAccount(context.Background(), &banking.UpdateAccountRequest{
+		Account: &banking.Account{
+			Id:          ids[0],
+			DisplayName: "Payroll",
+			OwnerId:     "ignored",
+			Labels:      map[string]string{"team": "payroll"},
+			Balance:     usd(1_000_000),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"displayName", "labels"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Payroll", res.Account.DisplayName)
+	assert.Empty(t, res.Account.OwnerId)
+	assert.Equal(t, map[string]string{"team": "payroll"}, res.Account.Labels)
+	assertProtoEqual(t, usd(10), res.Account.Balance)
+	assert.True(t, res.Account.UpdatedAt.AsTime().After(before.UpdatedAt.AsTime()))
+
+	_, err = s.UpdateAccount(context.Background(), &banking.UpdateAccountRequest{
+		Account:    &banking.Account{Id: ids[0]},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"balance"}},
+	})
+	assert.Contains(t, violatedFields(t, err), "updateMask")
+
+	_, err = s.UpdateAccount(context.Background(), &banking.UpdateAccountRequest{
+		Account:    &banking.Account{Id: "00000000-0000-0000-0000-000000000000"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"type"}},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,341 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/yaml.v3"
+)
+
+// AdminScope lets a principal act on every account, not only those it owns.
+const AdminScope = "banking:admin"
+
+var UnauthenticatedError = errors.New("Missing or invalid credentials")
+var PermissionDeniedError = errors.New("Permission denied")
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	// Subject identifies the caller. It is matched against the ownerId of
+	// accounts.
+	Subject string
+	Scopes  []string
+}
+
+func (p *Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+type principalKey struct{}
+
+// ContextWithPrincipal returns a copy of ctx carrying p.
+func ContextWithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal authenticated for the request
+// ctx belongs to, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// Authenticator resolves the bearer token of a request to the principal it
+// was issued to. Implementations must be safe for concurrent use.
+type Authenticator interface {
+	Authenticate(token string) (*Principal, error)
+}
+
+// Authenticators tries each of its authenticators in turn, so that, for
+// example, both API keys and JWTs are accepted.
+type Authenticators []Authenticator
+
+func (a Authenticators) Authenticate(token string) (*Principal, error) {
+	err := UnauthenticatedError
+	for _, authenticator := range a {
+		var p *Principal
+		if p, err = authenticator.Authenticate(token); err == nil {
+			return p, nil
+		}
+	}
+	return nil, err
+}
+
+// APIKeys authenticates static API keys. Only digests of the keys are kept
+// in memory.
+type APIKeys struct {
+	principals map[[sha256.Size]byte]*Principal
+}
+
+// apiKeyEntry is one entry of an API keys file.
+type apiKeyEntry struct {
+	Key     string   `yaml:"key"`
+	Subject string   `yaml:"subject"`
+	Scopes  []string `yaml:"scopes"`
+}
+
+// LoadAPIKeys reads a YAML list of API keys, each with the key, the subject
+// it authenticates as and optionally its scopes.
+func LoadAPIKeys(file string) (*APIKeys, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(b))
+	decoder.KnownFields(true)
+	var entries []apiKeyEntry
+	if err := decoder.Decode(&entries); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	keys := &APIKeys{principals: make(map[[sha256.Size]byte]*Principal)}
+	for i, entry := range entries {
+		if entry.Key == "" || entry.Subject == "" {
+			return nil, fmt.Errorf("%s: entry %d must have a key and a subject", file, i)
+		}
+		digest := sha256.Sum256([]byte(entry.Key))
+		if keys.principals[digest] != nil {
+			return nil, fmt.Errorf("%s: entry %d repeats a key", file, i)
+		}
+		keys.principals[digest] = &Principal{Subject: entry.Subject, Scopes: entry.Scopes}
+	}
+	return keys, nil
+}
+
+func (k *APIKeys) Authenticate(token string) (*Principal, error) {
+	if p, ok := k.principals[sha256.Sum256([]byte(token))]; ok {
+		return p, nil
+	}
+	return nil, fmt.Errorf("%w: unknown API key", UnauthenticatedError)
+}
+
+// JWTAuthenticator authenticates JWTs signed with one of a set of public
+// keys. The sub claim is the principal's subject, and its scopes come from
+// the space separated scope claim or the scp list.
+type JWTAuthenticator struct {
+	// Issuer, when set, must match the iss claim.
+	Issuer string
+	// Audience, when set, must be one of the aud claims.
+	Audience string
+	keys     []jsonWebKey
+}
+
+// jsonWebKey is a public key from a JWKS, with the algorithms it may verify.
+type jsonWebKey struct {
+	id         string
+	key        crypto.PublicKey
+	algorithms []string
+}
+
+// LoadJWKS reads the verification keys of a JWTAuthenticator from a JSON
+// Web Key Set file. RSA, EC and Ed25519 keys are supported; keys for
+// encryption are ignored.
+func LoadJWKS(file string) (*JWTAuthenticator, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var set struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			Alg string `json:"alg"`
+			Crv string `json:"crv"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(b, &set); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	a := &JWTAuthenticator{}
+	for i, k := range set.Keys {
+		if k.Use == "enc" {
+			continue
+		}
+		key, algorithms, err := parseJSONWebKey(k.Kty, k.Crv, k.N, k.E, k.X, k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("%s: key %d: %w", file, i, err)
+		}
+		if k.Alg != "" {
+			if !slices.Contains(algorithms, k.Alg) {
+				return nil, fmt.Errorf("%s: key %d: alg %s does not suit a %s key", file, i, k.Alg, k.Kty)
+			}
+			algorithms = []string{k.Alg}
+		}
+		a.keys = append(a.keys, jsonWebKey{id: k.Kid, key: key, algorithms: algorithms})
+	}
+	if len(a.keys) == 0 {
+		return nil, fmt.Errorf("%s: no signing keys", file)
+	}
+	return a, nil
+}
+
+func parseJSONWebKey(kty, crv, n, e, x, y string) (crypto.PublicKey, []string, error) {
+	switch kty {
+	case "RSA":
+		nb, err1 := base64.RawURLEncoding.DecodeString(n)
+		eb, err2 := base64.RawURLEncoding.DecodeString(e)
+		exponent := new(big.Int).SetBytes(eb)
+		if err := errors.Join(err1, err2); err != nil || len(nb) == 0 || !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+			return nil, nil, errors.New("bad RSA key")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(nb), E: int(exponent.Int64())},
+			[]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}, nil
+	case "EC":
+		curves := map[string]struct {
+			curve elliptic.Curve
+			alg   string
+		}{
+			"P-256": {elliptic.P256(), "ES256"},
+			"P-384": {elliptic.P384(), "ES384"},
+			"P-521": {elliptic.P521(), "ES512"},
+		}
+		c, ok := curves[crv]
+		if !ok {
+			return nil, nil, fmt.Errorf("unsupported curve %q", crv)
+		}
+		xb, err1 := base64.RawURLEncoding.DecodeString(x)
+		yb, err2 := base64.RawURLEncoding.DecodeString(y)
+		key := &ecdsa.PublicKey{Curve: c.curve, X: new(big.Int).SetBytes(xb), Y: new(big.Int).SetBytes(yb)}
+		if errors.Join(err1, err2) != nil || !c.curve.IsOnCurve(key.X, key.Y) {
+			return nil, nil, errors.New("bad EC key")
+		}
+		return key, []string{c.alg}, nil
+	case "OKP":
+		xb, err := base64.RawURLEncoding.DecodeString(x)
+		if crv != "Ed25519" || err != nil || len(xb) != ed25519.PublicKeySize {
+			return nil, nil, errors.New("bad OKP key; only Ed25519 is supported")
+		}
+		return ed25519.PublicKey(xb), []string{"EdDSA"}, nil
+	}
+	return nil, nil, fmt.Errorf("unsupported key type %q", kty)
+}
+
+func (a *JWTAuthenticator) Authenticate(token string) (*Principal, error) {
+	opts := []jwt.ParserOption{jwt.WithExpirationRequired(), jwt.WithLeeway(time.Minute)}
+	if a.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(a.Issuer))
+	}
+	if a.Audience != "" {
+		opts = append(opts, jwt.WithAudience(a.Audience))
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, a.verificationKey, opts...); err != nil {
+		return nil, fmt.Errorf("%w: %v", UnauthenticatedError, err)
+	}
+	subject, _ := claims.GetSubject()
+	if subject == "" {
+		return nil, fmt.Errorf("%w: token has no subject", UnauthenticatedError)
+	}
+	p := &Principal{Subject: subject}
+	if scope, ok := claims["scope"].(string); ok {
+		p.Scopes = strings.Fields(scope)
+	}
+	switch scp := claims["scp"].(type) {
+	case string:
+		p.Scopes = append(p.Scopes, strings.Fields(scp)...)
+	case []any:
+		for _, s := range scp {
+			if s, ok := s.(string); ok {
+				p.Scopes = append(p.Scopes, s)
+			}
+		}
+	}
+	return p, nil
+}
+
+// verificationKey picks the key named by the kid header, or the only key
+// when the token does not name one, provided it may verify the token's alg.
+func (a *JWTAuthenticator) verificationKey(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	for _, k := range a.keys {
+		if k.id != kid && (kid != "" || len(a.keys) > 1) {
+			continue
+		}
+		if !slices.Contains(k.algorithms, token.Method.Alg()) {
+			return nil, fmt.Errorf("key %q does not verify %s", kid, token.Method.Alg())
+		}
+		return k.key, nil
+	}
+	return nil, fmt.Errorf("no key %q", kid)
+}
+
+// publicMethods may be called without credentials.
+var publicMethods = map[string]bool{
+	banking.BankingService_Ping_FullMethodName: true,
+}
+
+// authenticate resolves the bearer token in the authorization metadata of
+// ctx, returning ctx with the principal attached.
+func (s *Server) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if s.Authenticator == nil || publicMethods[method] {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) != 1 {
+		return nil, statusError(fmt.Errorf("%w: expected one authorization header", UnauthenticatedError))
+	}
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return nil, statusError(fmt.Errorf("%w: expected a bearer token", UnauthenticatedError))
+	}
+	p, err := s.Authenticator.Authenticate(token)
+	if err != nil {
+		if !errors.Is(err, UnauthenticatedError) {
+			err = fmt.Errorf("%w: %v", UnauthenticatedError, err)
+		}
+		return nil, statusError(err)
+	}
+	return ContextWithPrincipal(ctx, p), nil
+}
+
+// authenticationUnaryInterceptor rejects calls without valid credentials
+// before anything else looks at them.
+func (s *Server) authenticationUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := s.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Server) authenticationStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := s.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+}
+
+// contextStream is a stream whose context has been replaced, e.g. to carry
+// the principal.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
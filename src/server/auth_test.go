@@ -0,0 +1,199 @@
+package server
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func writeAPIKeys(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadAPIKeys(t *testing.T) {
+	keys, err := LoadAPIKeys(writeAPIKeys(t, `
+- key: alice-key
+  subject: alice
+- key: ops-key
+  subject: ops
+  scopes: [banking:admin]
+`))
+	require.NoError(t, err)
+
+	p, err := keys.Authenticate("alice-key")
+	require.NoError(t, err)
+	assert.Equal(t, &Principal{Subject: "alice"}, p)
+	p, err = keys.Authenticate("ops-key")
+	require.NoError(t, err)
+	assert.True(t, p.HasScope(AdminScope))
+	_, err = keys.Authenticate("mallory-key")
+	assert.ErrorIs(t, err, UnauthenticatedError)
+
+	_, err = LoadAPIKeys(writeAPIKeys(t, "- key: k\n"))
+	assert.ErrorContains(t, err, "must have a key and a subject")
+	_, err = LoadAPIKeys(writeAPIKeys(t, "- key: k\n  subject: a\n- key: k\n  subject: b\n"))
+	assert.ErrorContains(t, err, "repeats a key")
+	_, err = LoadAPIKeys(writeAPIKeys(t, "- key: k\n  subject: a\n  role: admin\n"))
+	assert.ErrorContains(t, err, "role")
+}
+
+// testJWKS writes a JWKS holding the public halves of keys, named by kid,
+// and returns an authenticator loaded from it.
+func testJWKS(t *testing.T, keys map[string]crypto.Signer) *JWTAuthenticator {
+	encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	var set struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	for kid, key := range keys {
+		jwk := map[string]string{"kid": kid}
+		switch pub := key.Public().(type) {
+		case *rsa.PublicKey:
+			jwk["kty"], jwk["n"], jwk["e"] = "RSA", encode(pub.N.Bytes()), encode(big.NewInt(int64(pub.E)).Bytes())
+		case *ecdsa.PublicKey:
+			jwk["kty"], jwk["crv"], jwk["x"], jwk["y"] = "EC", "P-256", encode(pub.X.FillBytes(make([]byte, 32))), encode(pub.Y.FillBytes(make([]byte, 32)))
+		case ed25519.PublicKey:
+			jwk["kty"], jwk["crv"], jwk["x"] = "OKP", "Ed25519", encode(pub)
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	b, err := json.Marshal(set)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, b, 0o600))
+	a, err := LoadJWKS(path)
+	require.NoError(t, err)
+	return a
+}
+
+func signTestJWT(t *testing.T, method jwt.SigningMethod, kid string, key crypto.Signer, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	a := testJWKS(t, map[string]crypto.Signer{"rsa": rsaKey, "ec": ecKey, "ed": edKey})
+	a.Issuer, a.Audience = "https://issuer.example", "banking"
+
+	exp := time.Now().Add(time.Hour).Unix()
+	claims := func(extra jwt.MapClaims) jwt.MapClaims {
+		c := jwt.MapClaims{"sub": "alice", "iss": a.Issuer, "aud": a.Audience, "exp": exp}
+		for k, v := range extra {
+			c[k] = v
+		}
+		return c
+	}
+
+	for _, token := range []string{
+		signTestJWT(t, jwt.SigningMethodRS256, "rsa", rsaKey, claims(jwt.MapClaims{"scope": "banking:read banking:admin"})),
+		signTestJWT(t, jwt.SigningMethodES256, "ec", ecKey, claims(jwt.MapClaims{"scp": []string{"banking:read", "banking:admin"}})),
+		signTestJWT(t, jwt.SigningMethodEdDSA, "ed", edKey, claims(jwt.MapClaims{"scp": "banking:read banking:admin"})),
+	} {
+		p, err := a.Authenticate(token)
+		require.NoError(t, err)
+		assert.Equal(t, &Principal{Subject: "alice", Scopes: []string{"banking:read", AdminScope}}, p)
+	}
+
+	for name, token := range map[string]string{
+		"expired":        signTestJWT(t, jwt.SigningMethodES256, "ec", ecKey, claims(jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()})),
+		"no expiry":      signTestJWT(t, jwt.SigningMethodES256, "ec", ecKey, jwt.MapClaims{"sub": "alice", "iss": a.Issuer, "aud": a.Audience}),
+		"wrong issuer":   signTestJWT(t, jwt.SigningMethodES256, "ec", ecKey, claims(jwt.MapClaims{"iss": "https://elsewhere.example"})),
+		"wrong audience": signTestJWT(t, jwt.SigningMethodES256, "ec", ecKey, claims(jwt.MapClaims{"aud": "payments"})),
+		"no subject":     signTestJWT(t, jwt.SigningMethodES256, "ec", ecKey, claims(jwt.MapClaims{"sub": ""})),
+		"unknown kid":    signTestJWT(t, jwt.SigningMethodES256, "other", ecKey, claims(nil)),
+		"wrong key":      signTestJWT(t, jwt.SigningMethodRS256, "ec", rsaKey, claims(nil)),
+		"malformed":      "not.a.jwt",
+	} {
+		_, err := a.Authenticate(token)
+		assert.ErrorIs(t, err, UnauthenticatedError, name)
+	}
+}
+
+func TestLoadJWKS_Invalid(t *testing.T) {
+	for name, content := range map[string]string{
+		"not JSON":      "keys",
+		"no keys":       `{"keys": []}`,
+		"only enc":      `{"keys": [{"kty": "OKP", "crv": "Ed25519", "x": "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo", "use": "enc"}]}`,
+		"bad curve":     `{"keys": [{"kty": "EC", "crv": "P-192", "x": "AA", "y": "AA"}]}`,
+		"off curve":     `{"keys": [{"kty": "EC", "crv": "P-256", "x": "AQ", "y": "AQ"}]}`,
+		"wrong alg":     `{"keys": [{"kty": "OKP", "crv": "Ed25519", "x": "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo", "alg": "RS256"}]}`,
+		"symmetric":     `{"keys": [{"kty": "oct", "k": "c2VjcmV0"}]}`,
+		"short Ed25519": `{"keys": [{"kty": "OKP", "crv": "Ed25519", "x": "AQ"}]}`,
+	} {
+		path := filepath.Join(t.TempDir(), "jwks.json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		_, err := LoadJWKS(path)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestServer_Authentication(t *testing.T) {
+	keys, err := LoadAPIKeys(writeAPIKeys(t, "- key: alice-key\n  subject: alice\n"))
+	require.NoError(t, err)
+	s := getNewTestServer()
+	s.Authenticator = keys
+	addr := startTestServer(t, s)
+
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := banking.NewBankingServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = client.Ping(ctx, &banking.PingRequest{Message: "ping"})
+	assert.NoError(t, err, "Ping is public")
+
+	req := &banking.AccountRequest{InitialBalance: usd(0)}
+	for _, header := range []string{"", "alice-key", "Bearer", "Bearer mallory-key"} {
+		ctx := ctx
+		if header != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", header)
+		}
+		_, err = client.CreateAccount(ctx, req)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err), header)
+	}
+
+	res, err := client.CreateAccount(metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer alice-key"), req)
+	require.NoError(t, err)
+	account, err := s.store.GetAccount(context.Background(), res.AccountId)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", account.OwnerId)
+
+	// Streams are authenticated too.
+	stream, err := client.WatchAccount(ctx, &banking.WatchAccountRequest{AccountIds: []string{res.AccountId}})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,208 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"google.golang.org/grpc"
+)
+
+// authorize checks that the principal of ctx may make req. Principals with
+// AdminScope may make any request. Others may only read and debit accounts
+// they own, may only list what belongs to them, and may neither open
+// internal accounts nor change the type of an account. Requests this
+// policy does not know of need AdminScope. Nothing is checked while the
+// server has no Authenticator.
+//
+// ListAccount and CreateAccount requests that leave ownerId empty are
+// scoped to the principal by setting it.
+func (s *Server) authorize(ctx context.Context, req any) error {
+	if s.Authenticator == nil {
+		return nil
+	}
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		if _, ok := req.(*banking.PingRequest); ok {
+			return nil
+		}
+		return statusError(UnauthenticatedError)
+	}
+	if p.HasScope(AdminScope) {
+		return nil
+	}
+
+	switch req := req.(type) {
+	case *banking.PingRequest:
+		return nil
+	case *banking.AccountRequest:
+		return s.authorizeCreateAccount(p, req)
+	case *banking.ListAccountRequest:
+		if req.OwnerId == "" {
+			req.OwnerId = p.Subject
+		}
+		if req.OwnerId != p.Subject {
+			return permissionDenied(p, "list the accounts of %s", req.OwnerId)
+		}
+		return nil
+	case *banking.BalanceRequest:
+		return s.authorizeAccount(ctx, p, req.AccountId)
+	case *banking.WatchAccountRequest:
+		for _, id := range req.AccountIds {
+			if err := s.authorizeAccount(ctx, p, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *banking.UpdateAccountRequest:
+		if req.UpdateMask != nil && slices.Contains(req.UpdateMask.Paths, "ownerId") {
+			return permissionDenied(p, "change the owner of an account")
+		}
+		if req.UpdateMask != nil && slices.Contains(req.UpdateMask.Paths, "type") {
+			return permissionDenied(p, "change the type of an account")
+		}
+		return s.authorizeAccount(ctx, p, req.Account.GetId())
+	case *banking.CloseAccountRequest:
+		return s.authorizeAccount(ctx, p, req.AccountId)
+	case *banking.TransactionRequest:
+		return s.authorizeAccount(ctx, p, req.FromAccountId)
+	case *banking.AuthorizeTransferRequest:
+		return s.authorizeAccount(ctx, p, req.FromAccountId)
+	case *banking.ScheduleTransferRequest:
+		return s.authorizeAccount(ctx, p, req.FromAccountId)
+	case *banking.JournalEntryRequest:
+		for _, leg := range req.Legs {
+			if moneyNanos(leg.Amount).Sign() < 0 {
+				if err := s.authorizeAccount(ctx, p, leg.AccountId); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	case *banking.TransactionDetailsRequest:
+		return s.authorizeTransaction(ctx, p, req.TransactionId, func(tx *banking.Transaction) []string {
+			return []string{tx.FromAccountId, tx.ToAccountId}
+		})
+	case *banking.CaptureTransferRequest:
+		return s.authorizeTransaction(ctx, p, req.TransactionId, func(tx *banking.Transaction) []string {
+			return []string{tx.FromAccountId}
+		})
+	case *banking.VoidTransferRequest:
+		return s.authorizeTransaction(ctx, p, req.TransactionId, func(tx *banking.Transaction) []string {
+			return []string{tx.FromAccountId}
+		})
+	case *banking.ReverseTransactionRequest:
+		// A reversal debits the account that was credited.
+		return s.authorizeTransaction(ctx, p, req.TransactionId, func(tx *banking.Transaction) []string {
+			return []string{tx.ToAccountId}
+		})
+	case *banking.ListTransactionsRequest:
+		if req.AccountId == "" {
+			return permissionDenied(p, "list transactions without an accountId")
+		}
+		return s.authorizeAccount(ctx, p, req.AccountId)
+	case *banking.ListJournalEntriesRequest:
+		if req.AccountId == "" {
+			return permissionDenied(p, "list journal entries without an accountId")
+		}
+		return s.authorizeAccount(ctx, p, req.AccountId)
+	case *banking.ListSchedulesRequest:
+		if req.AccountId == "" {
+			return permissionDenied(p, "list schedules without an accountId")
+		}
+		return s.authorizeAccount(ctx, p, req.AccountId)
+	case *banking.CancelScheduleRequest:
+		schedule, err := s.store.GetSchedule(ctx, req.ScheduleId)
+		if err != nil {
+			return statusError(err)
+		}
+		return s.authorizeAccount(ctx, p, schedule.FromAccountId)
+	}
+	return permissionDenied(p, "call this method")
+}
+
+func (s *Server) authorizeCreateAccount(p *Principal, req *banking.AccountRequest) error {
+	if req.OwnerId == "" {
+		req.OwnerId = p.Subject
+	}
+	if req.OwnerId != p.Subject {
+		return permissionDenied(p, "create accounts for %s", req.OwnerId)
+	}
+	// Anything else would create money.
+	if moneyNanos(req.InitialBalance).Sign() != 0 {
+		return permissionDenied(p, "open an account with a balance")
+	}
+	if req.Type == banking.AccountType_ACCOUNT_TYPE_INTERNAL {
+		return permissionDenied(p, "open an internal account")
+	}
+	return nil
+}
+
+// authorizeAccount checks that p owns the account id. The accounts of
+// others are reported as not found, so that their IDs cannot be told apart
+// from unknown ones.
+func (s *Server) authorizeAccount(ctx context.Context, p *Principal, id string) error {
+	account, err := s.store.GetAccount(ctx, id)
+	if err != nil {
+		return statusError(err)
+	}
+	if account.OwnerId != p.Subject {
+		return statusError(accountNotFound(id))
+	}
+	return nil
+}
+
+// authorizeTransaction checks that p owns one of the accounts of the
+// transaction id that accounts picks. Like accounts, transactions between
+// the accounts of others are reported as not found.
+func (s *Server) authorizeTransaction(ctx context.Context, p *Principal, id string, accounts func(*banking.Transaction) []string) error {
+	tx, err := s.store.GetTransaction(ctx, id)
+	if err != nil {
+		return statusError(err)
+	}
+	for _, accountID := range accounts(tx) {
+		if s.authorizeAccount(ctx, p, accountID) == nil {
+			return nil
+		}
+	}
+	if s.authorizeAccount(ctx, p, tx.FromAccountId) != nil && s.authorizeAccount(ctx, p, tx.ToAccountId) != nil {
+		return statusError(transactionNotFound(id))
+	}
+	return statusError(&ResourceError{Err: PermissionDeniedError, ResourceType: transactionResourceType, Name: id})
+}
+
+func permissionDenied(p *Principal, action string, args ...any) error {
+	return statusError(fmt.Errorf("%w: %s may not %s without the %s scope",
+		PermissionDeniedError, p.Subject, fmt.Sprintf(action, args...), AdminScope))
+}
+
+// authorizationUnaryInterceptor applies the authorisation policy to every
+// call once its request has been validated.
+func (s *Server) authorizationUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := s.authorize(ctx, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// authorizationStreamInterceptor applies the policy to every message a
+// client streams in, except on streams that authorise each item themselves.
+func (s *Server) authorizationStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if itemValidatedMethods[info.FullMethod] {
+		return handler(srv, ss)
+	}
+	return handler(srv, &authorizingStream{ServerStream: ss, s: s})
+}
+
+type authorizingStream struct {
+	grpc.ServerStream
+	s *Server
+}
+
+func (a *authorizingStream) RecvMsg(m any) error {
+	if err := a.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return a.s.authorize(a.Context(), m)
+}
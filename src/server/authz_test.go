@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func createOwnedTestAccount(t *testing.T, s *Server, owner string, balance int64) string {
+	res, err := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(balance), OwnerId: owner})
+	require.NoError(t, err)
+	return res.AccountId
+}
+
+func TestServer_Authorize(t *testing.T) {
+	s := getNewTestServer()
+	alices := createOwnedTestAccount(t, s, "alice", 100)
+	bobs := createOwnedTestAccount(t, s, "bob", 100)
+	transfer := makeTestTransfers(t, s, alices, bobs, 10)[0]
+	hold := authorizeTestTransfer(t, s, alices, bobs, 10)
+	schedule, err := s.ScheduleTransfer(context.Background(), &banking.ScheduleTransferRequest{
+		FromAccountId: alices,
+		ToAccountId:   bobs,
+		Amount:        usd(1),
+		StartAt:       timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	require.NoError(t, err)
+	s.Authenticator = Authenticators{}
+
+	tests := []struct {
+		name string
+		req  any
+		want codes.Code
+	}{
+		{"ping", &banking.PingRequest{}, codes.OK},
+		{"create own account", &banking.AccountRequest{InitialBalance: usd(0)}, codes.OK},
+		{"create account for another", &banking.AccountRequest{InitialBalance: usd(0), OwnerId: "bob"}, codes.PermissionDenied},
+		{"create account with money", &banking.AccountRequest{InitialBalance: usd(1)}, codes.PermissionDenied},
+		{"create internal account", &banking.AccountRequest{
+			InitialBalance: usd(0), Type: banking.AccountType_ACCOUNT_TYPE_INTERNAL,
+		}, codes.PermissionDenied},
+		{"list own accounts", &banking.ListAccountRequest{}, codes.OK},
+		{"list another's accounts", &banking.ListAccountRequest{OwnerId: "bob"}, codes.PermissionDenied},
+		{"own balance", &banking.BalanceRequest{AccountId: alices}, codes.OK},
+		{"another's balance", &banking.BalanceRequest{AccountId: bobs}, codes.NotFound},
+		{"watch own account", &banking.WatchAccountRequest{AccountIds: []string{alices}}, codes.OK},
+		{"watch another's account", &banking.WatchAccountRequest{AccountIds: []string{alices, bobs}}, codes.NotFound},
+		{"debit own account", &banking.TransactionRequest{FromAccountId: alices, ToAccountId: bobs}, codes.OK},
+		{"debit another's account", &banking.TransactionRequest{FromAccountId: bobs, ToAccountId: alices}, codes.NotFound},
+		{"authorise on own account", &banking.AuthorizeTransferRequest{FromAccountId: alices, ToAccountId: bobs}, codes.OK},
+		{"authorise on another's account", &banking.AuthorizeTransferRequest{FromAccountId: bobs, ToAccountId: alices}, codes.NotFound},
+		{"schedule from own account", &banking.ScheduleTransferRequest{FromAccountId: alices, ToAccountId: bobs}, codes.OK},
+		{"schedule from another's account", &banking.ScheduleTransferRequest{FromAccountId: bobs, ToAccountId: alices}, codes.NotFound},
+		{"journal debiting own account", &banking.JournalEntryRequest{Legs: []*banking.JournalLeg{
+			{AccountId: alices, Amount: usd(-1)}, {AccountId: bobs, Amount: usd(1)},
+		}}, codes.OK},
+		{"journal debiting another's account", &banking.JournalEntryRequest{Legs: []*banking.JournalLeg{
+			{AccountId: alices, Amount: usd(1)}, {AccountId: bobs, Amount: usd(-1)},
+		}}, codes.NotFound},
+		{"details as sender", &banking.TransactionDetailsRequest{TransactionId: transfer}, codes.OK},
+		{"capture own hold", &banking.CaptureTransferRequest{TransactionId: hold}, codes.OK},
+		{"void own hold", &banking.VoidTransferRequest{TransactionId: hold}, codes.OK},
+		{"reverse as sender", &banking.ReverseTransactionRequest{TransactionId: transfer}, codes.PermissionDenied},
+		{"list own transactions", &banking.ListTransactionsRequest{AccountId: alices}, codes.OK},
+		{"list all transactions", &banking.ListTransactionsRequest{}, codes.PermissionDenied},
+		{"list another's transactions", &banking.ListTransactionsRequest{AccountId: bobs}, codes.NotFound},
+		{"list own journal entries", &banking.ListJournalEntriesRequest{AccountId: alices}, codes.OK},
+		{"list all journal entries", &banking.ListJournalEntriesRequest{}, codes.PermissionDenied},
+		{"list another's journal entries", &banking.ListJournalEntriesRequest{AccountId: bobs}, codes.NotFound},
+		{"list own schedules", &banking.ListSchedulesRequest{AccountId: alices}, codes.OK},
+		{"list all schedules", &banking.ListSchedulesRequest{}, codes.PermissionDenied},
+		{"cancel own schedule", &banking.CancelScheduleRequest{ScheduleId: schedule.Schedule.ScheduleId}, codes.OK},
+		{"update own account", &banking.UpdateAccountRequest{
+			Account:    &banking.Account{Id: alices, DisplayName: "Savings"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"displayName"}},
+		}, codes.OK},
+		{"give away own account", &banking.UpdateAccountRequest{
+			Account:    &banking.Account{Id: alices, OwnerId: "bob"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"ownerId"}},
+		}, codes.PermissionDenied},
+		{"make own account internal", &banking.UpdateAccountRequest{
+			Account:    &banking.Account{Id: alices, Type: banking.AccountType_ACCOUNT_TYPE_INTERNAL},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"type"}},
+		}, codes.PermissionDenied},
+		{"change type of own account", &banking.UpdateAccountRequest{
+			Account:    &banking.Account{Id: alices, Type: banking.AccountType_ACCOUNT_TYPE_SAVINGS},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"type"}},
+		}, codes.PermissionDenied},
+		{"close own account", &banking.CloseAccountRequest{AccountId: alices}, codes.OK},
+		{"close another's account", &banking.CloseAccountRequest{AccountId: bobs}, codes.NotFound},
+		{"freeze own account", &banking.AccountStatusRequest{AccountId: alices}, codes.PermissionDenied},
+	}
+	alice := ContextWithPrincipal(context.Background(), &Principal{Subject: "alice"})
+	admin := ContextWithPrincipal(context.Background(), &Principal{Subject: "ops", Scopes: []string{AdminScope}})
+	for _, tt := range tests {
+		err := s.authorize(alice, tt.req)
+		assert.Equal(t, tt.want, status.Code(err), tt.name)
+		if tt.want == codes.PermissionDenied {
+			assert.Equal(t, ReasonPermissionDenied, errorDetail[*errdetails.ErrorInfo](t, err).Reason, tt.name)
+		}
+		assert.NoError(t, s.authorize(admin, tt.req), tt.name)
+	}
+
+	bob := ContextWithPrincipal(context.Background(), &Principal{Subject: "bob"})
+	assert.NoError(t, s.authorize(bob, &banking.TransactionDetailsRequest{TransactionId: transfer}), "details as recipient")
+	assert.NoError(t, s.authorize(bob, &banking.ReverseTransactionRequest{TransactionId: transfer}), "reverse as recipient")
+	assert.Equal(t, codes.PermissionDenied, status.Code(s.authorize(bob, &banking.CaptureTransferRequest{TransactionId: hold})))
+
+	// Unknown accounts and those of others look the same.
+	err = s.authorize(alice, &banking.BalanceRequest{AccountId: "00000000-0000-0000-0000-000000000000"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	err = s.authorize(alice, &banking.BalanceRequest{AccountId: bobs})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, ReasonAccountNotFound, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+	carols := createOwnedTestAccount(t, s, "carol", 0)
+	others := makeTestTransfers(t, s, bobs, carols, 1)[0]
+	err = s.authorize(alice, &banking.TransactionDetailsRequest{TransactionId: others})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, codes.Unauthenticated, status.Code(s.authorize(context.Background(), &banking.BalanceRequest{AccountId: alices})))
+}
+
+func TestServer_AuthorizeScopesListings(t *testing.T) {
+	s := getNewTestServer()
+	alices := createOwnedTestAccount(t, s, "alice", 100)
+	createOwnedTestAccount(t, s, "bob", 100)
+	s.Authenticator = Authenticators{}
+	alice := ContextWithPrincipal(context.Background(), &Principal{Subject: "alice"})
+
+	req := &banking.ListAccountRequest{}
+	require.NoError(t, s.authorize(alice, req))
+	res, err := s.ListAccount(alice, req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{alices}, accountIDs(res.Accounts))
+}
+
+func TestServer_BatchTransferAuthorizesEachItem(t *testing.T) {
+	s := getNewTestServer()
+	alices := createOwnedTestAccount(t, s, "alice", 100)
+	bobs := createOwnedTestAccount(t, s, "bob", 100)
+	s.Authenticator = Authenticators{}
+	alice := ContextWithPrincipal(context.Background(), &Principal{Subject: "alice"})
+
+	responses := s.postBatch(alice, []*banking.BatchTransferRequest{
+		{Sequence: 1, Transaction: &banking.TransactionRequest{FromAccountId: alices, ToAccountId: bobs, Amount: usd(10)}},
+		{Sequence: 2, Transaction: &banking.TransactionRequest{FromAccountId: bobs, ToAccountId: alices, Amount: usd(10)}},
+	})
+	assert.NotNil(t, responses[0].GetResponse())
+	assert.Equal(t, int32(codes.NotFound), responses[1].GetError().GetCode())
+}
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) BatchTransfer(stream banking.BankingService_BatchTransferServer) error {
+	ctx := stream.Context()
+	items := make(chan *banking.BatchTransferRequest, s.batchSize())
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(items)
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					recvErr <- err
+				}
+				return
+			}
+			select {
+			case items <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		batch, more := s.nextBatch(items)
+		for _, res := range s.postBatch(ctx, batch) {
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+		if !more {
+			break
+		}
+	}
+	select {
+	case err := <-recvErr:
+		return err
+	default:
+		return nil
+	}
+}
+
+func (s *Server) batchSize() int {
+	if s.BatchSize <= 0 {
+		return 1
+	}
+	return s.BatchSize
+}
+
+// nextBatch waits for the next request and then gathers up to BatchSize
+// requests, waiting at most BatchWindow for more to arrive. It returns false
+// once items is closed.
+func (s *Server) nextBatch(items <-chan *banking.BatchTransferRequest) ([]*banking.BatchTransferRequest, bool) {
+	req, ok := <-items
+	if !ok {
+		return nil, false
+	}
+	batch := []*banking.BatchTransferRequest{req}
+
+	window := time.NewTimer(s.BatchWindow)
+	defer window.Stop()
+	for len(batch) < s.batchSize() {
+		select {
+		case req, ok := <-items:
+			if !ok {
+				return batch, false
+			}
+			batch = append(batch, req)
+		case <-window.C:
+			return batch, true
+		}
+	}
+	return batch, true
+}
+
+// postBatch posts the transfers in batch, committing together all those
+// that can be. Requests carrying an idempotency key are posted on their own
+// through MakeTransaction.
+func (s *Server) postBatch(ctx context.Context, batch []*banking.BatchTransferRequest) []*banking.BatchTransferResponse {
+	responses := make([]*banking.BatchTransferResponse, len(batch))
+	var pending []*banking.Transaction
+	var pendingIdx []int
+	for i, req := range batch {
+		responses[i] = &banking.BatchTransferResponse{Sequence: req.Sequence}
+		if req.Transaction == nil {
+			setBatchResult(responses[i], nil, invalidArgument(FieldViolation{"transaction", "is required"}))
+			continue
+		}
+		if err := validateRequest(req.Transaction); err != nil {
+			setBatchResult(responses[i], nil, err)
+			continue
+		}
+		if err := s.authorize(ctx, req.Transaction); err != nil {
+			setBatchResult(responses[i], nil, err)
+			continue
+		}
+		if req.Transaction.IdempotencyKey != "" {
+			res, err := s.MakeTransaction(ctx, req.Transaction)
+			setBatchResult(responses[i], res, err)
+			continue
+		}
+		tx, err := s.newTransaction(ctx, req.Transaction)
+		if err != nil {
+			setBatchResult(responses[i], nil, err)
+			continue
+		}
+		pending = append(pending, tx)
+		pendingIdx = append(pendingIdx, i)
+	}
+
+	for n, err := range s.transferBatch(ctx, pending) {
+		if err != nil {
+			s.recordFailure(ctx, pending[n], err)
+			setBatchResult(responses[pendingIdx[n]], nil, statusError(err))
+			continue
+		}
+		setBatchResult(responses[pendingIdx[n]], s.transactionPosted(ctx, pending[n]), nil)
+	}
+	return responses
+}
+
+// transferBatch assigns each of txs a fresh ID and posts them together,
+// retrying those whose ID collides as transfer does.
+func (s *Server) transferBatch(ctx context.Context, txs []*banking.Transaction) []error {
+	errs := make([]error, len(txs))
+	pending := make([]int, len(txs))
+	for i := range txs {
+		pending[i] = i
+	}
+	for attempt := 0; attempt < maxIDAttempts && len(pending) > 0; attempt++ {
+		var batch []*banking.Transaction
+		var batchIdx []int
+		for _, i := range pending {
+			id, err := s.TransactionIDs.NewID()
+			if err != nil {
+				errs[i] = err
+				continue
+			}
+			txs[i].TransactionId = id
+			txs[i].IdFormat = s.TransactionIDs.Format()
+			batch = append(batch, txs[i])
+			batchIdx = append(batchIdx, i)
+		}
+
+		var retry []int
+		for n, err := range s.store.TransferBatch(ctx, batch) {
+			errs[batchIdx[n]] = err
+			if errors.Is(err, TransactionExistsError) {
+				retry = append(retry, batchIdx[n])
+			}
+		}
+		pending = retry
+	}
+	return errs
+}
+
+func setBatchResult(res *banking.BatchTransferResponse, tx *banking.TransactionResponse, err error) {
+	if err != nil {
+		p := status.Convert(err).Proto()
+		res.Result = &banking.BatchTransferResponse_Error{Error: &banking.BatchTransferError{
+			Code:    p.Code,
+			Message: p.Message,
+			Details: p.Details,
+		}}
+		return
+	}
+	res.Result = &banking.BatchTransferResponse_Response{Response: tx}
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+type fakeBatchStream struct {
+	grpc.ServerStream
+	requests  chan *banking.BatchTransferRequest
+	responses []*banking.BatchTransferResponse
+}
+
+func (f *fakeBatchStream) Context() context.Context {
+	return context.Background()
+}
+
+func (f *fakeBatchStream) Recv() (*banking.BatchTransferRequest, error) {
+	req, ok := <-f.requests
+	if !ok {
+		return nil, io.EOF
+	}
+	return req, nil
+}
+
+func (f *fakeBatchStream) Send(res *banking.BatchTransferResponse) error {
+	f.responses = append(f.responses, res)
+	return nil
+}
+
+// batchTransfer runs a BatchTransfer stream carrying reqs to completion.
+func batchTransfer(t *testing.T, s *Server, reqs ...*banking.BatchTransferRequest) []*banking.BatchTransferResponse {
+	stream := &fakeBatchStream{requests: make(chan *banking.BatchTransferRequest, len(reqs))}
+	for _, req := range reqs {
+		stream.requests <- req
+	}
+	close(stream.requests)
+	require.NoError(t, s.BatchTransfer(stream))
+	return stream.responses
+}
+
+func TestBatchTransfer(t *testing.T) {
+	s := getNewTestServer()
+	s.BatchWindow = time.Second
+	accounts := createTestAccounts(t, s, 100, 0)
+	transfer := func(seq uint64, from, to string, amount int64) *banking.BatchTransferRequest {
+		return &banking.BatchTransferRequest{
+			Sequence:    seq,
+			Transaction: &banking.TransactionRequest{FromAccountId: from, ToAccountId: to, Amount: usd(amount)},
+		}
+	}
+
+	responses := batchTransfer(t, s,
+		transfer(10, accounts[0], accounts[1], 60),
+		transfer(11, accounts[0], accounts[1], 60),
+		transfer(12, accounts[0], uuid.NewString(), 1),
+		transfer(13, accounts[0], accounts[0], 1),
+		&banking.BatchTransferRequest{Sequence: 14},
+	)
+	require.Len(t, responses, 5)
+	for i, res := range responses {
+		assert.Equal(t, uint64(10+i), res.Sequence)
+	}
+
+	posted := responses[0].GetResponse()
+	require.NotNil(t, posted)
+	tx, err := s.store.GetTransaction(context.Background(), posted.TransactionId)
+	require.NoError(t, err)
+	assertProtoEqual(t, usd(60), tx.Amount)
+
+	assert.Equal(t, int32(codes.FailedPrecondition), responses[1].GetError().GetCode())
+	assert.Equal(t, int32(codes.NotFound), responses[2].GetError().GetCode())
+	assert.Equal(t, int32(codes.InvalidArgument), responses[3].GetError().GetCode())
+	assert.Equal(t, int32(codes.InvalidArgument), responses[4].GetError().GetCode())
+
+	balance, err := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: accounts[1]})
+	require.NoError(t, err)
+	assertProtoEqual(t, usd(60), balance.Balance)
+}
+
+func TestBatchTransfer_IdempotencyKey(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0)
+	req := &banking.TransactionRequest{
+		FromAccountId:  accounts[0],
+		ToAccountId:    accounts[1],
+		Amount:         usd(10),
+		IdempotencyKey: "key",
+	}
+
+	responses := batchTransfer(t, s,
+		&banking.BatchTransferRequest{Sequence: 1, Transaction: req},
+		&banking.BatchTransferRequest{Sequence: 2, Transaction: req},
+	)
+	require.Len(t, responses, 2)
+	assert.Equal(t, responses[0].GetResponse().GetTransactionId(), responses[1].GetResponse().GetTransactionId())
+
+	balance, err := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: accounts[1]})
+	require.NoError(t, err)
+	assertProtoEqual(t, usd(10), balance.Balance)
+}
@@ -0,0 +1,466 @@
+package server
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/bryanvaz/grpc-gl/src/telemetry"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix prefixes the environment variable of every configuration flag,
+// e.g. BANKING_LISTEN_ADDRESS for -listen-address.
+const EnvPrefix = "BANKING_"
+
+// Config is everything a server can be configured with. LoadConfig takes
+// values from, in increasing order of precedence, DefaultConfig, a YAML
+// file, environment variables and command line flags.
+type Config struct {
+	// ListenAddress is the host:port to serve on.
+	ListenAddress string `yaml:"listenAddress"`
+	// MetricsAddress is the host:port Prometheus metrics are served on, at
+	// /metrics. Empty disables them.
+	MetricsAddress string `yaml:"metricsAddress"`
+	// Reflection registers the gRPC reflection service.
+	Reflection bool `yaml:"reflection"`
+	// LogLevel is one of debug, info, warn or error. Every call is logged
+	// at info; the details of each, with account IDs and amounts in full,
+	// only at debug.
+	LogLevel string `yaml:"logLevel"`
+	// LogFormat is json or text.
+	LogFormat string                  `yaml:"logFormat"`
+	TLS       TLSConfig               `yaml:"tls"`
+	Auth      AuthConfig              `yaml:"auth"`
+	Storage   StorageConfig           `yaml:"storage"`
+	Limits    LimitsConfig            `yaml:"limits"`
+	Tracing   telemetry.TracingConfig `yaml:"tracing"`
+}
+
+// TLSConfig enables TLS when both CertFile and KeyFile are set. The files
+// are reloaded when they change.
+type TLSConfig struct {
+	CertFile string `yaml:"certFile"`
+	KeyFile  string `yaml:"keyFile"`
+	// ClientCAFile, when set, requires clients to present a certificate
+	// signed by one of its CAs.
+	ClientCAFile string `yaml:"clientCAFile"`
+}
+
+// AuthConfig requires every call but Ping to carry a bearer token when
+// either file is set. Tokens are tried as API keys first, then as JWTs.
+type AuthConfig struct {
+	// APIKeysFile is a YAML list of API keys; see LoadAPIKeys.
+	APIKeysFile string `yaml:"apiKeysFile"`
+	// JWKSFile is a JSON Web Key Set of the keys JWTs may be signed with.
+	JWKSFile string `yaml:"jwksFile"`
+	// Issuer and Audience, when set, must match the iss and aud claims of
+	// JWTs.
+	Issuer   string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+}
+
+type StorageConfig struct {
+	// Backend is "wal" to journal state to DataDir, or "memory" to keep
+	// it in process memory only.
+	Backend          string        `yaml:"backend"`
+	DataDir          string        `yaml:"dataDir"`
+	SnapshotInterval time.Duration `yaml:"snapshotInterval"`
+}
+
+// LimitsConfig holds the tuning knobs of a Server. Each is documented on
+// the Server field of the same name.
+type LimitsConfig struct {
+	IdempotencyTTL     time.Duration `yaml:"idempotencyTTL"`
+	BatchSize          int           `yaml:"batchSize"`
+	BatchWindow        time.Duration `yaml:"batchWindow"`
+	HoldTTL            time.Duration `yaml:"holdTTL"`
+	HoldExpiryInterval time.Duration `yaml:"holdExpiryInterval"`
+	ScheduleInterval   time.Duration `yaml:"scheduleInterval"`
+	// ScheduleCatchUp is one of skip, run-once or run-all.
+	ScheduleCatchUp      string `yaml:"scheduleCatchUp"`
+	MaxRecvMsgSize       int    `yaml:"maxRecvMsgSize"`
+	MaxConcurrentStreams uint32 `yaml:"maxConcurrentStreams"`
+}
+
+var logLevels = []string{"debug", "info", "warn", "error"}
+
+var logFormats = []string{"json", "text"}
+
+var storageBackends = []string{"wal", "memory"}
+
+var catchUpPolicies = map[string]banking.CatchUpPolicy{
+	"skip":     banking.CatchUpPolicy_CATCH_UP_POLICY_SKIP,
+	"run-once": banking.CatchUpPolicy_CATCH_UP_POLICY_RUN_ONCE,
+	"run-all":  banking.CatchUpPolicy_CATCH_UP_POLICY_RUN_ALL,
+}
+
+// DefaultConfig returns the configuration of a server given no flags,
+// environment or file.
+func DefaultConfig() Config {
+	return Config{
+		ListenAddress:  ":50051",
+		MetricsAddress: ":9090",
+		Reflection:     true,
+		LogLevel:       "info",
+		LogFormat:      "json",
+		Storage: StorageConfig{
+			Backend:          "wal",
+			DataDir:          "data",
+			SnapshotInterval: 5 * time.Minute,
+		},
+		Limits: LimitsConfig{
+			IdempotencyTTL:     24 * time.Hour,
+			BatchSize:          100,
+			BatchWindow:        2 * time.Millisecond,
+			HoldTTL:            7 * 24 * time.Hour,
+			HoldExpiryInterval: time.Minute,
+			ScheduleInterval:   time.Second,
+			ScheduleCatchUp:    "run-once",
+		},
+		Tracing: telemetry.TracingConfig{
+			Exporter:    "none",
+			Endpoint:    "http://localhost:4317",
+			SampleRatio: 1,
+		},
+	}
+}
+
+// LoadConfig builds the configuration given command line args, without the
+// program name, and an environment lookup such as os.LookupEnv. A -config
+// flag, or BANKING_CONFIG, names a YAML file to read. The result has been
+// validated.
+func LoadConfig(args []string, lookupEnv func(string) (string, bool)) (*Config, error) {
+	cfg := DefaultConfig()
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage of %s:\n", fs.Name())
+		fs.PrintDefaults()
+		fmt.Fprintf(fs.Output(), "\nEvery flag can also be set in the environment, e.g. %s for -listen-address.\n",
+			envName("listen-address"))
+	}
+	configFile := fs.String("config", "", "YAML configuration `file`")
+	cfg.bindFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	// The flags were parsed into cfg to find -config. Keep them aside, then
+	// start again from the defaults so that the file and environment can be
+	// applied beneath them.
+	set := make(map[string]string)
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = f.Value.String() })
+	cfg = DefaultConfig()
+
+	path := *configFile
+	if _, ok := set["config"]; !ok {
+		path, _ = lookupEnv(envName("config"))
+	}
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	var errs []error
+	fs.VisitAll(func(f *flag.Flag) {
+		if f.Name == "config" {
+			return
+		}
+		if value, ok := set[f.Name]; ok {
+			errs = append(errs, fs.Set(f.Name, value))
+		} else if value, ok := lookupEnv(envName(f.Name)); ok {
+			if err := fs.Set(f.Name, value); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", envName(f.Name), err))
+			}
+		}
+	})
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// bindFlags defines a flag for every field of c, defaulting to its current
+// value.
+func (c *Config) bindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.ListenAddress, "listen-address", c.ListenAddress, "host:port to serve on")
+	fs.StringVar(&c.MetricsAddress, "metrics-address", c.MetricsAddress, "host:port to serve Prometheus metrics on at /metrics; empty disables them")
+	fs.BoolVar(&c.Reflection, "reflection", c.Reflection, "register the gRPC reflection service")
+	fs.StringVar(&c.LogLevel, "log-level", c.LogLevel, "one of "+strings.Join(logLevels, ", "))
+	fs.StringVar(&c.LogFormat, "log-format", c.LogFormat, "one of "+strings.Join(logFormats, ", "))
+	fs.StringVar(&c.TLS.CertFile, "tls-cert", c.TLS.CertFile, "PEM certificate file; enables TLS together with -tls-key")
+	fs.StringVar(&c.TLS.KeyFile, "tls-key", c.TLS.KeyFile, "PEM private key file")
+	fs.StringVar(&c.TLS.ClientCAFile, "tls-client-ca", c.TLS.ClientCAFile, "PEM CA certificates; requires clients to present a certificate they signed")
+	fs.StringVar(&c.Auth.APIKeysFile, "auth-api-keys", c.Auth.APIKeysFile, "YAML file of API keys; requires callers to authenticate")
+	fs.StringVar(&c.Auth.JWKSFile, "auth-jwks", c.Auth.JWKSFile, "JSON Web Key Set that JWTs are verified with; requires callers to authenticate")
+	fs.StringVar(&c.Auth.Issuer, "auth-issuer", c.Auth.Issuer, "iss claim JWTs must have")
+	fs.StringVar(&c.Auth.Audience, "auth-audience", c.Auth.Audience, "aud claim JWTs must have")
+	fs.StringVar(&c.Storage.Backend, "storage", c.Storage.Backend, "one of "+strings.Join(storageBackends, ", "))
+	fs.StringVar(&c.Storage.DataDir, "data-dir", c.Storage.DataDir, "directory for the write-ahead log")
+	fs.DurationVar(&c.Storage.SnapshotInterval, "snapshot-interval", c.Storage.SnapshotInterval, "how often the write-ahead log is compacted; 0 disables")
+	fs.DurationVar(&c.Limits.IdempotencyTTL, "idempotency-ttl", c.Limits.IdempotencyTTL, "how long idempotency keys are remembered")
+	fs.IntVar(&c.Limits.BatchSize, "batch-size", c.Limits.BatchSize, "most transfers a BatchTransfer stream commits at once")
+	fs.DurationVar(&c.Limits.BatchWindow, "batch-window", c.Limits.BatchWindow, "how long a BatchTransfer stream waits to fill a batch")
+	fs.DurationVar(&c.Limits.HoldTTL, "hold-ttl", c.Limits.HoldTTL, "default lifetime of an authorisation hold")
+	fs.DurationVar(&c.Limits.HoldExpiryInterval, "hold-expiry-interval", c.Limits.HoldExpiryInterval, "how often lapsed holds are released; 0 disables")
+	fs.DurationVar(&c.Limits.ScheduleInterval, "schedule-interval", c.Limits.ScheduleInterval, "how often due scheduled transfers are made; 0 disables")
+	fs.StringVar(&c.Limits.ScheduleCatchUp, "schedule-catch-up", c.Limits.ScheduleCatchUp, "default catch-up policy: skip, run-once or run-all")
+	fs.IntVar(&c.Limits.MaxRecvMsgSize, "max-recv-msg-size", c.Limits.MaxRecvMsgSize, "largest request accepted, in bytes; 0 keeps the gRPC default")
+	fs.Var((*uint32Value)(&c.Limits.MaxConcurrentStreams), "max-concurrent-streams", "`streams` allowed per connection; 0 is unlimited")
+	fs.StringVar(&c.Tracing.Exporter, "trace-exporter", c.Tracing.Exporter, "where spans are sent: one of "+strings.Join(telemetry.Exporters, ", "))
+	fs.StringVar(&c.Tracing.Endpoint, "trace-endpoint", c.Tracing.Endpoint, "OTLP/gRPC collector `URL` for the otlp exporter; http is plaintext")
+	fs.StringVar(&c.Tracing.File, "trace-file", c.Tracing.File, "file the file exporter appends spans to")
+	fs.Float64Var(&c.Tracing.SampleRatio, "trace-sample-ratio", c.Tracing.SampleRatio, "fraction of traces started by the server that are recorded")
+}
+
+// uint32Value is a flag.Value for uint32 fields, which the flag package
+// lacks.
+type uint32Value uint32
+
+func (v *uint32Value) String() string {
+	return strconv.FormatUint(uint64(*v), 10)
+}
+
+func (v *uint32Value) Set(s string) error {
+	n, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return err
+	}
+	*v = uint32Value(n)
+	return nil
+}
+
+// envName returns the environment variable for the named flag.
+func envName(flagName string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// loadFile reads path over c. Unknown keys are rejected so that typos are
+// not silently ignored.
+func (c *Config) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// Validate reports every problem with c.
+func (c *Config) Validate() error {
+	var errs []error
+	invalid := func(field, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: "+format, append([]any{field}, args...)...))
+	}
+
+	if err := validateAddress(c.ListenAddress); err != nil {
+		invalid("listenAddress", "%v", err)
+	}
+	if c.MetricsAddress != "" {
+		if err := validateAddress(c.MetricsAddress); err != nil {
+			invalid("metricsAddress", "%v", err)
+		} else if c.MetricsAddress == c.ListenAddress {
+			invalid("metricsAddress", "must differ from listenAddress")
+		}
+	}
+	if !slices.Contains(logLevels, c.LogLevel) {
+		invalid("logLevel", "must be one of %s", strings.Join(logLevels, ", "))
+	}
+	if !slices.Contains(logFormats, c.LogFormat) {
+		invalid("logFormat", "must be one of %s", strings.Join(logFormats, ", "))
+	}
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		invalid("tls", "certFile and keyFile must be set together")
+	}
+	if c.TLS.ClientCAFile != "" && c.TLS.CertFile == "" {
+		invalid("tls.clientCAFile", "requires certFile and keyFile")
+	}
+	for _, file := range []struct{ field, path string }{
+		{"tls.certFile", c.TLS.CertFile},
+		{"tls.keyFile", c.TLS.KeyFile},
+		{"tls.clientCAFile", c.TLS.ClientCAFile},
+		{"auth.apiKeysFile", c.Auth.APIKeysFile},
+		{"auth.jwksFile", c.Auth.JWKSFile},
+	} {
+		if file.path == "" {
+			continue
+		}
+		if _, err := os.Stat(file.path); err != nil {
+			invalid(file.field, "%v", err)
+		}
+	}
+
+	if (c.Auth.Issuer != "" || c.Auth.Audience != "") && c.Auth.JWKSFile == "" {
+		invalid("auth", "issuer and audience require jwksFile")
+	}
+
+	switch c.Storage.Backend {
+	case "wal":
+		if c.Storage.DataDir == "" {
+			invalid("storage.dataDir", "is required by the wal backend")
+		}
+	case "memory":
+	default:
+		invalid("storage.backend", "must be one of %s", strings.Join(storageBackends, ", "))
+	}
+	if c.Storage.SnapshotInterval < 0 {
+		invalid("storage.snapshotInterval", "must not be negative")
+	}
+
+	l := c.Limits
+	if l.IdempotencyTTL <= 0 {
+		invalid("limits.idempotencyTTL", "must be positive")
+	}
+	if l.BatchSize <= 0 {
+		invalid("limits.batchSize", "must be positive")
+	}
+	if l.BatchWindow < 0 {
+		invalid("limits.batchWindow", "must not be negative")
+	}
+	if l.HoldTTL <= 0 {
+		invalid("limits.holdTTL", "must be positive")
+	}
+	if l.HoldExpiryInterval < 0 {
+		invalid("limits.holdExpiryInterval", "must not be negative")
+	}
+	if l.ScheduleInterval < 0 {
+		invalid("limits.scheduleInterval", "must not be negative")
+	}
+	if _, ok := catchUpPolicies[l.ScheduleCatchUp]; !ok {
+		invalid("limits.scheduleCatchUp", "must be one of skip, run-once, run-all")
+	}
+	if l.MaxRecvMsgSize < 0 {
+		invalid("limits.maxRecvMsgSize", "must not be negative")
+	}
+
+	tr := c.Tracing
+	switch tr.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if u, err := url.Parse(tr.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			invalid("tracing.endpoint", "must be an http or https URL")
+		}
+	case "file":
+		if tr.File == "" {
+			invalid("tracing.file", "is required by the file exporter")
+		}
+	default:
+		invalid("tracing.exporter", "must be one of %s", strings.Join(telemetry.Exporters, ", "))
+	}
+	if tr.SampleRatio < 0 || tr.SampleRatio > 1 {
+		invalid("tracing.sampleRatio", "must be between 0 and 1")
+	}
+	return errors.Join(errs...)
+}
+
+// validateAddress checks that addr is a host:port with a fixed port.
+func validateAddress(addr string) error {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	n, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return fmt.Errorf("bad port %q", port)
+	}
+	if n == 0 {
+		return errors.New("port must not be 0")
+	}
+	return nil
+}
+
+// String renders c as YAML that loadFile reads back.
+func (c *Config) String() string {
+	b, err := yaml.Marshal(c)
+	if err != nil {
+		return err.Error()
+	}
+	return string(b)
+}
+
+// LogValue logs c with the same field names and values as String.
+func (c *Config) LogValue() slog.Value {
+	var fields map[string]any
+	if err := yaml.Unmarshal([]byte(c.String()), &fields); err != nil {
+		return slog.StringValue(c.String())
+	}
+	return slog.AnyValue(fields)
+}
+
+// NewServer returns a server configured by c, which must be valid, with a
+// store of the configured backend.
+func (c *Config) NewServer() (*Server, error) {
+	logger := NewLogger(os.Stderr, logLevelNames[c.LogLevel], c.LogFormat)
+	var store Store = NewMemoryStore()
+	if c.Storage.Backend == "wal" {
+		wal := NewWALStore(c.Storage.DataDir)
+		wal.SnapshotInterval = c.Storage.SnapshotInterval
+		wal.Logger = logger
+		store = wal
+	}
+
+	s := NewServer(store)
+	s.Address = c.ListenAddress
+	s.MetricsAddress = c.MetricsAddress
+	s.Logger = logger
+	s.Reflection = c.Reflection
+	if c.TLS.CertFile != "" {
+		creds, err := NewServerTLS(c.TLS.CertFile, c.TLS.KeyFile, c.TLS.ClientCAFile, s.Logger)
+		if err != nil {
+			return nil, err
+		}
+		s.Credentials = creds
+	}
+	var authenticators Authenticators
+	if c.Auth.APIKeysFile != "" {
+		keys, err := LoadAPIKeys(c.Auth.APIKeysFile)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, keys)
+	}
+	if c.Auth.JWKSFile != "" {
+		jwts, err := LoadJWKS(c.Auth.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		jwts.Issuer, jwts.Audience = c.Auth.Issuer, c.Auth.Audience
+		authenticators = append(authenticators, jwts)
+	}
+	if len(authenticators) > 0 {
+		s.Authenticator = authenticators
+	}
+	s.MaxRecvMsgSize = c.Limits.MaxRecvMsgSize
+	s.MaxConcurrentStreams = c.Limits.MaxConcurrentStreams
+	s.IdempotencyTTL = c.Limits.IdempotencyTTL
+	s.BatchSize = c.Limits.BatchSize
+	s.BatchWindow = c.Limits.BatchWindow
+	s.HoldTTL = c.Limits.HoldTTL
+	s.HoldExpiryInterval = c.Limits.HoldExpiryInterval
+	s.ScheduleInterval = c.Limits.ScheduleInterval
+	s.ScheduleCatchUp = catchUpPolicies[c.Limits.ScheduleCatchUp]
+	return s, nil
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func env(vars map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		v, ok := vars[name]
+		return v, ok
+	}
+}
+
+func writeConfigFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestLoadConfig_Defaults(t *testing.T) {
+	cfg, err := LoadConfig(nil, env(nil))
+	require.NoError(t, err)
+	assert.Equal(t, DefaultConfig(), *cfg)
+}
+
+func TestLoadConfig_Precedence(t *testing.T) {
+	path := writeConfigFile(t, `
+listenAddress: ":6000"
+logLevel: info
+storage:
+  backend: memory
+limits:
+  batchSize: 10
+  holdTTL: 48h
+  scheduleCatchUp: skip
+`)
+	cfg, err := LoadConfig(
+		[]string{"-config", path, "-batch-size", "30"},
+		env(map[string]string{
+			"BANKING_LOG_LEVEL":  "warn",
+			"BANKING_BATCH_SIZE": "20",
+			"BANKING_HOLD_TTL":   "1h",
+		}),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, ":6000", cfg.ListenAddress)
+	assert.Equal(t, "warn", cfg.LogLevel)
+	assert.Equal(t, "memory", cfg.Storage.Backend)
+	assert.Equal(t, 30, cfg.Limits.BatchSize)
+	assert.Equal(t, time.Hour, cfg.Limits.HoldTTL)
+	assert.Equal(t, "skip", cfg.Limits.ScheduleCatchUp)
+	// Untouched settings keep their defaults.
+	assert.Equal(t, DefaultConfig().Limits.IdempotencyTTL, cfg.Limits.IdempotencyTTL)
+}
+
+func TestLoadConfig_FileFromEnvironment(t *testing.T) {
+	path := writeConfigFile(t, "listenAddress: \"127.0.0.1:7000\"\n")
+	cfg, err := LoadConfig(nil, env(map[string]string{"BANKING_CONFIG": path}))
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:7000", cfg.ListenAddress)
+}
+
+func TestLoadConfig_Invalid(t *testing.T) {
+	_, err := LoadConfig([]string{"-config", writeConfigFile(t, "listenAdress: \":6000\"\n")}, env(nil))
+	assert.ErrorContains(t, err, "listenAdress")
+
+	_, err = LoadConfig(nil, env(map[string]string{"BANKING_BATCH_WINDOW": "soon"}))
+	assert.ErrorContains(t, err, "BANKING_BATCH_WINDOW")
+
+	_, err = LoadConfig([]string{"-listen-address", ":0", "-metrics-address", "localhost", "-log-level", "loud", "-storage", "wal", "-data-dir", "",
+		"-tls-key", "key.pem", "-tls-client-ca", "ca.pem", "-auth-issuer", "https://issuer.example", "-batch-size", "0", "-schedule-catch-up", "never",
+		"-trace-exporter", "jaeger", "-trace-sample-ratio", "2"}, env(nil))
+	require.Error(t, err)
+	for _, field := range []string{"listenAddress", "metricsAddress", "logLevel", "storage.dataDir", "tls", "tls.clientCAFile", "auth", "limits.batchSize", "limits.scheduleCatchUp", "tracing.exporter", "tracing.sampleRatio"} {
+		assert.ErrorContains(t, err, field+":")
+	}
+}
+
+func TestConfig_StringRoundTrips(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.ListenAddress = "localhost:6000"
+	cfg.Limits.MaxConcurrentStreams = 8
+
+	loaded, err := LoadConfig([]string{"-config", writeConfigFile(t, cfg.String())}, env(nil))
+	require.NoError(t, err)
+	assert.Equal(t, cfg, *loaded)
+}
+
+func TestConfig_NewServer(t *testing.T) {
+	cfg, err := LoadConfig([]string{
+		"-storage", "memory",
+		"-listen-address", "localhost:6000",
+		"-metrics-address", "localhost:6001",
+		"-reflection=false",
+		"-batch-size", "5",
+		"-schedule-catch-up", "run-all",
+		"-max-concurrent-streams", "16",
+	}, env(nil))
+	require.NoError(t, err)
+
+	s, err := cfg.NewServer()
+	require.NoError(t, err)
+	assert.IsType(t, &MemoryStore{}, s.store)
+	assert.Equal(t, "localhost:6000", s.Address)
+	assert.Equal(t, "localhost:6001", s.MetricsAddress)
+	assert.False(t, s.Reflection)
+	assert.Equal(t, 5, s.BatchSize)
+	assert.Equal(t, banking.CatchUpPolicy_CATCH_UP_POLICY_RUN_ALL, s.ScheduleCatchUp)
+	assert.Equal(t, uint32(16), s.MaxConcurrentStreams)
+	assert.Nil(t, s.Credentials)
+	assert.Nil(t, s.Authenticator)
+}
+
+func TestConfig_NewServerWithAuth(t *testing.T) {
+	cfg, err := LoadConfig([]string{"-storage", "memory", "-auth-api-keys", writeAPIKeys(t, "- key: k\n  subject: alice\n")}, env(nil))
+	require.NoError(t, err)
+
+	s, err := cfg.NewServer()
+	require.NoError(t, err)
+	require.NotNil(t, s.Authenticator)
+	p, err := s.Authenticator.Authenticate("k")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", p.Subject)
+}
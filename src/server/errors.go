@@ -0,0 +1,249 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// ErrorDomain is the google.rpc.ErrorInfo domain of every error returned by
+// the BankingService.
+const ErrorDomain = "banking"
+
+// Error reasons, reported in the google.rpc.ErrorInfo detail attached to
+// every error returned by the BankingService. Clients should branch on these
+// rather than on messages. The catalogue in README.md lists which code and
+// further details accompany each one.
+const (
+	ReasonInvalidArgument             = "INVALID_ARGUMENT"
+	ReasonInvalidPageToken            = "INVALID_PAGE_TOKEN"
+	ReasonUnauthenticated             = "UNAUTHENTICATED"
+	ReasonPermissionDenied            = "PERMISSION_DENIED"
+	ReasonAccountNotFound             = "ACCOUNT_NOT_FOUND"
+	ReasonTransactionNotFound         = "TRANSACTION_NOT_FOUND"
+	ReasonScheduleNotFound            = "SCHEDULE_NOT_FOUND"
+	ReasonAccountExists               = "ACCOUNT_EXISTS"
+	ReasonTransactionExists           = "TRANSACTION_EXISTS"
+	ReasonJournalEntryExists          = "JOURNAL_ENTRY_EXISTS"
+	ReasonScheduleExists              = "SCHEDULE_EXISTS"
+	ReasonInsufficientFunds           = "INSUFFICIENT_FUNDS"
+	ReasonCurrencyMismatch            = "CURRENCY_MISMATCH"
+	ReasonCrossCurrencyDisabled       = "CROSS_CURRENCY_DISABLED"
+	ReasonExchangeRateUnavailable     = "EXCHANGE_RATE_UNAVAILABLE"
+	ReasonAmountOverflow              = "AMOUNT_OVERFLOW"
+	ReasonIdempotencyKeyReused        = "IDEMPOTENCY_KEY_REUSED"
+	ReasonAccountFrozen               = "ACCOUNT_FROZEN"
+	ReasonAccountClosed               = "ACCOUNT_CLOSED"
+	ReasonAccountNotEmpty             = "ACCOUNT_NOT_EMPTY"
+	ReasonReversalExceedsOriginal     = "REVERSAL_EXCEEDS_ORIGINAL"
+	ReasonTransactionNotReversible    = "TRANSACTION_NOT_REVERSIBLE"
+	ReasonHoldNotPending              = "HOLD_NOT_PENDING"
+	ReasonHoldExpired                 = "HOLD_EXPIRED"
+	ReasonCaptureExceedsAuthorization = "CAPTURE_EXCEEDS_AUTHORIZATION"
+	ReasonScheduleNotActive           = "SCHEDULE_NOT_ACTIVE"
+	ReasonWatchLagged                 = "WATCH_LAGGED"
+	ReasonSequenceExpired             = "SEQUENCE_EXPIRED"
+	ReasonInternal                    = "INTERNAL"
+)
+
+const (
+	accountResourceType      = "banking.Account"
+	transactionResourceType  = "banking.Transaction"
+	journalEntryResourceType = "banking.JournalEntry"
+	scheduleResourceType     = "banking.Schedule"
+)
+
+var AccountNotFoundError = errors.New("Account not found")
+var TransactionNotFoundError = errors.New("Transaction not found")
+var AccountExistsError = errors.New("Account already exists")
+var TransactionExistsError = errors.New("Transaction already exists")
+var JournalEntryExistsError = errors.New("Journal entry already exists")
+var IdempotencyKeyReusedError = errors.New("Idempotency key was already used with different parameters")
+var AccountFrozenError = errors.New("Account is frozen")
+var AccountClosedError = errors.New("Account is closed")
+var AccountNotEmptyError = errors.New("Account balance is not zero")
+var ReversalExceedsOriginalError = errors.New("Reversal exceeds the original amount")
+var TransactionNotReversibleError = errors.New("Transaction cannot be reversed")
+var HoldNotPendingError = errors.New("Transaction is not a pending authorisation")
+var HoldExpiredError = errors.New("Authorisation has expired")
+var CaptureExceedsAuthorizationError = errors.New("Capture exceeds the authorised amount")
+var ScheduleNotFoundError = errors.New("Schedule not found")
+var ScheduleExistsError = errors.New("Schedule already exists")
+var ScheduleNotActiveError = errors.New("Schedule is no longer active")
+
+// errorCatalogue maps sentinel errors to the status code and reason they
+// are reported with.
+var errorCatalogue = []struct {
+	err    error
+	code   codes.Code
+	reason string
+}{
+	{UnauthenticatedError, codes.Unauthenticated, ReasonUnauthenticated},
+	{PermissionDeniedError, codes.PermissionDenied, ReasonPermissionDenied},
+	{AccountNotFoundError, codes.NotFound, ReasonAccountNotFound},
+	{TransactionNotFoundError, codes.NotFound, ReasonTransactionNotFound},
+	{AccountExistsError, codes.AlreadyExists, ReasonAccountExists},
+	{TransactionExistsError, codes.AlreadyExists, ReasonTransactionExists},
+	{JournalEntryExistsError, codes.AlreadyExists, ReasonJournalEntryExists},
+	{ScheduleNotFoundError, codes.NotFound, ReasonScheduleNotFound},
+	{ScheduleExistsError, codes.AlreadyExists, ReasonScheduleExists},
+	{InvalidMoneyError, codes.InvalidArgument, ReasonInvalidArgument},
+	{InvalidPageTokenError, codes.InvalidArgument, ReasonInvalidPageToken},
+	{CurrencyMismatchError, codes.FailedPrecondition, ReasonCurrencyMismatch},
+	{CrossCurrencyDisabledError, codes.FailedPrecondition, ReasonCrossCurrencyDisabled},
+	{ExchangeRateUnavailableError, codes.FailedPrecondition, ReasonExchangeRateUnavailable},
+	{MoneyOverflowError, codes.OutOfRange, ReasonAmountOverflow},
+	{IdempotencyKeyReusedError, codes.FailedPrecondition, ReasonIdempotencyKeyReused},
+	{AccountFrozenError, codes.FailedPrecondition, ReasonAccountFrozen},
+	{AccountClosedError, codes.FailedPrecondition, ReasonAccountClosed},
+	{AccountNotEmptyError, codes.FailedPrecondition, ReasonAccountNotEmpty},
+	{ReversalExceedsOriginalError, codes.FailedPrecondition, ReasonReversalExceedsOriginal},
+	{TransactionNotReversibleError, codes.FailedPrecondition, ReasonTransactionNotReversible},
+	{HoldNotPendingError, codes.FailedPrecondition, ReasonHoldNotPending},
+	{HoldExpiredError, codes.FailedPrecondition, ReasonHoldExpired},
+	{CaptureExceedsAuthorizationError, codes.FailedPrecondition, ReasonCaptureExceedsAuthorization},
+	{ScheduleNotActiveError, codes.FailedPrecondition, ReasonScheduleNotActive},
+	{WatchLaggedError, codes.ResourceExhausted, ReasonWatchLagged},
+	{SequenceExpiredError, codes.OutOfRange, ReasonSequenceExpired},
+}
+
+// ResourceError reports that a resource is missing or already exists. Err
+// is the sentinel describing which, and is what errors.Is matches.
+type ResourceError struct {
+	Err          error
+	ResourceType string
+	Name         string
+}
+
+func accountNotFound(id string) error {
+	return &ResourceError{Err: AccountNotFoundError, ResourceType: accountResourceType, Name: id}
+}
+
+func transactionNotFound(id string) error {
+	return &ResourceError{Err: TransactionNotFoundError, ResourceType: transactionResourceType, Name: id}
+}
+
+func scheduleNotFound(id string) error {
+	return &ResourceError{Err: ScheduleNotFoundError, ResourceType: scheduleResourceType, Name: id}
+}
+
+func (e *ResourceError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err, e.Name)
+}
+
+func (e *ResourceError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ResourceError) GRPCStatus() *status.Status {
+	code, reason := lookupError(e.Err)
+	return newStatus(code, reason, e.Error(), map[string]string{"resourceName": e.Name},
+		&errdetails.ResourceInfo{
+			ResourceType: e.ResourceType,
+			ResourceName: e.Name,
+			Description:  e.Err.Error(),
+		},
+	)
+}
+
+// InsufficientFundsError is returned by Store.Transfer when a debit would
+// take an account past what its overdraft policy allows.
+type InsufficientFundsError struct {
+	AccountID string
+	// Shortfall is how much more the account would need to cover the debit.
+	Shortfall *banking.Money
+}
+
+func (e *InsufficientFundsError) Error() string {
+	return fmt.Sprintf("Insufficient funds in account %s: short by %s", e.AccountID, formatMoney(e.Shortfall))
+}
+
+// GRPCStatus reports the error as FAILED_PRECONDITION, carrying the
+// shortfall so that clients do not have to parse the message.
+func (e *InsufficientFundsError) GRPCStatus() *status.Status {
+	metadata := map[string]string{
+		"accountId":    e.AccountID,
+		"shortfall":    strings.TrimSuffix(formatMoney(e.Shortfall), " "+e.Shortfall.CurrencyCode),
+		"currencyCode": e.Shortfall.CurrencyCode,
+	}
+	return newStatus(codes.FailedPrecondition, ReasonInsufficientFunds, e.Error(), metadata,
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{
+				Type:        ReasonInsufficientFunds,
+				Subject:     e.AccountID,
+				Description: e.Error(),
+			}},
+		},
+	)
+}
+
+// FieldViolation is one entry of a google.rpc.BadRequest detail.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// invalidArgument returns an INVALID_ARGUMENT error listing each bad field.
+func invalidArgument(violations ...FieldViolation) error {
+	br := &errdetails.BadRequest{}
+	descriptions := make([]string, len(violations))
+	for i, v := range violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+		descriptions[i] = v.Field + ": " + v.Description
+	}
+	msg := "Invalid request: " + strings.Join(descriptions, "; ")
+	return newStatus(codes.InvalidArgument, ReasonInvalidArgument, msg, nil, br).Err()
+}
+
+// statusError converts an error from the store or the money helpers into a
+// gRPC status error with the code and reason from the catalogue. Errors that
+// already carry a status are returned unchanged, and anything unrecognised
+// is reported as INTERNAL.
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var gs interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &gs) {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	code, reason := lookupError(err)
+	return newStatus(code, reason, err.Error(), nil).Err()
+}
+
+func lookupError(err error) (codes.Code, string) {
+	for _, entry := range errorCatalogue {
+		if errors.Is(err, entry.err) {
+			return entry.code, entry.reason
+		}
+	}
+	return codes.Internal, ReasonInternal
+}
+
+// newStatus builds a status carrying an ErrorInfo with reason and metadata,
+// followed by any further details.
+func newStatus(code codes.Code, reason, msg string, metadata map[string]string, details ...protoiface.MessageV1) *status.Status {
+	st := status.New(code, msg)
+	details = append([]protoiface.MessageV1{&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   ErrorDomain,
+		Metadata: metadata,
+	}}, details...)
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		return withDetails
+	}
+	return st
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDetail returns the first detail of type T attached to err.
+func errorDetail[T any](t *testing.T, err error) T {
+	t.Helper()
+	for _, d := range status.Convert(err).Details() {
+		if detail, ok := d.(T); ok {
+			return detail
+		}
+	}
+	var zero T
+	t.Fatalf("no %T detail in %v", zero, err)
+	return zero
+}
+
+func TestErrors_NotFound(t *testing.T) {
+	s := getNewTestServer()
+
+	_, err := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: "missing"})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, ReasonAccountNotFound, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+	info := errorDetail[*errdetails.ResourceInfo](t, err)
+	assert.Equal(t, "banking.Account", info.ResourceType)
+	assert.Equal(t, "missing", info.ResourceName)
+
+	_, err = s.GetTransactionDetails(context.Background(), &banking.TransactionDetailsRequest{TransactionId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, ReasonTransactionNotFound, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+}
+
+func TestErrors_MakeTransactionUnknownAccount(t *testing.T) {
+	s := getNewTestServer()
+	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+
+	_, err := s.MakeTransaction(context.Background(), &banking.TransactionRequest{
+		FromAccountId: ca1.AccountId,
+		ToAccountId:   "missing",
+		Amount:        usd(10),
+	})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, "missing", errorDetail[*errdetails.ResourceInfo](t, err).ResourceName)
+}
+
+func TestErrors_BadRequest(t *testing.T) {
+	s := getNewTestServer()
+
+	_, err := s.CreateAccount(context.Background(), &banking.AccountRequest{
+		OverdraftLimit: usd(10),
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, ReasonInvalidArgument, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+	violations := errorDetail[*errdetails.BadRequest](t, err).FieldViolations
+	assert.Len(t, violations, 2)
+	assert.Equal(t, "initialBalance", violations[0].Field)
+	assert.Equal(t, "overdraftLimit", violations[1].Field)
+}
+
+func TestStatusError(t *testing.T) {
+	err := statusError(&ResourceError{Err: AccountExistsError, ResourceType: accountResourceType, Name: "a"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.ErrorIs(t, err, AccountExistsError)
+
+	err = statusError(fmt.Errorf("wrapped: %w", MoneyOverflowError))
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	assert.Equal(t, ReasonAmountOverflow, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+
+	err = statusError(errors.New("disk on fire"))
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	err = statusError(context.Canceled)
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
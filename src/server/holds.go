@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) AuthorizeTransfer(ctx context.Context, req *banking.AuthorizeTransferRequest) (*banking.TransactionResponse, error) {
+	return idempotent(s.idempotency, ctx, s.IdempotencyTTL, "AuthorizeTransfer", req.IdempotencyKey, req,
+		func() (*banking.TransactionResponse, error) { return s.authorizeTransfer(ctx, req) })
+}
+
+func (s *Server) authorizeTransfer(ctx context.Context, req *banking.AuthorizeTransferRequest) (*banking.TransactionResponse, error) {
+	ttl := s.HoldTTL
+	if req.Ttl != nil {
+		if err := req.Ttl.CheckValid(); err != nil {
+			return nil, invalidArgument(FieldViolation{"ttl", err.Error()})
+		}
+		if ttl = req.Ttl.AsDuration(); ttl <= 0 {
+			return nil, invalidArgument(FieldViolation{"ttl", "must be positive"})
+		}
+	}
+
+	hold, err := s.newTransaction(ctx, &banking.TransactionRequest{
+		FromAccountId: req.FromAccountId,
+		ToAccountId:   req.ToAccountId,
+		Amount:        req.Amount,
+		Memo:          req.Memo,
+		Reference:     req.Reference,
+	})
+	if err != nil {
+		return nil, err
+	}
+	hold.Status = banking.TransactionStatus_TRANSACTION_STATUS_PENDING
+	hold.AuthorizedAmount = hold.Amount
+	hold.ExpiresAt = timestamppb.New(hold.CreatedAt.AsTime().Add(ttl))
+
+	if err := s.post(ctx, hold, s.store.AuthorizeHold); err != nil {
+		s.recordFailure(ctx, hold, err)
+		return nil, statusError(err)
+	}
+
+	s.logger(ctx).DebugContext(ctx, "Authorised transfer",
+		"transactionId", hold.TransactionId,
+		"fromAccountId", hold.FromAccountId,
+		"amount", formatMoney(hold.Amount),
+		"expiresAt", hold.ExpiresAt.AsTime(),
+	)
+
+	return &banking.TransactionResponse{
+		TransactionId: hold.TransactionId,
+		Success:       true,
+		Message:       "Transaction Authorized",
+		IdFormat:      hold.IdFormat,
+	}, nil
+}
+
+func (s *Server) CaptureTransfer(ctx context.Context, req *banking.CaptureTransferRequest) (*banking.TransactionResponse, error) {
+	return idempotent(s.idempotency, ctx, s.IdempotencyTTL, "CaptureTransfer", req.IdempotencyKey, req,
+		func() (*banking.TransactionResponse, error) { return s.captureTransfer(ctx, req) })
+}
+
+func (s *Server) captureTransfer(ctx context.Context, req *banking.CaptureTransferRequest) (*banking.TransactionResponse, error) {
+	if req.Amount != nil {
+		if err := validateMoney(req.Amount); err != nil {
+			return nil, invalidArgument(FieldViolation{"amount", err.Error()})
+		}
+	}
+
+	tx, err := s.store.CaptureHold(ctx, req.TransactionId, req.Amount)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return s.transactionPosted(ctx, tx), nil
+}
+
+func (s *Server) VoidTransfer(ctx context.Context, req *banking.VoidTransferRequest) (*banking.TransactionResponse, error) {
+	tx, err := s.store.VoidHold(ctx, req.TransactionId, req.Reason)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	s.logger(ctx).DebugContext(ctx, "Voided transfer", "transactionId", tx.TransactionId, "reason", req.Reason)
+
+	return &banking.TransactionResponse{
+		TransactionId: tx.TransactionId,
+		Success:       true,
+		Message:       "Transaction Voided",
+		IdFormat:      tx.IdFormat,
+	}, nil
+}
+
+// expireHolds releases lapsed holds every HoldExpiryInterval until done is
+// closed.
+func (s *Server) expireHolds(done <-chan struct{}) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.HoldExpiryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			ctx, span := tracer.Start(context.Background(), "Server.expireHolds")
+			ids, err := s.store.ExpireHolds(ctx, now)
+			span.End()
+			if err != nil {
+				s.Logger.Error("Failed to expire holds", "error", err)
+			} else if len(ids) > 0 {
+				s.Logger.Debug("Expired holds", "transactionIds", ids)
+			}
+		}
+	}
+}
+
+// availableBalance returns what can be spent from account before its
+// overdraft: its balance less everything held on it.
+func availableBalance(account *banking.Account) (*banking.Money, error) {
+	if account.HeldAmount == nil {
+		return account.Balance, nil
+	}
+	return subMoney(account.Balance, account.HeldAmount)
+}
@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func authorizeTestTransfer(t *testing.T, s *Server, from, to string, units int64) string {
+	res, err := s.AuthorizeTransfer(context.Background(), &banking.AuthorizeTransferRequest{
+		FromAccountId: from,
+		ToAccountId:   to,
+		Amount:        usd(units),
+	})
+	require.NoError(t, err)
+	return res.TransactionId
+}
+
+func balances(t *testing.T, s *Server, id string) (ledger, available *banking.Money) {
+	res, err := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: id})
+	require.NoError(t, err)
+	return res.Balance, res.AvailableBalance
+}
+
+func TestAuthorizeTransfer_HoldsFunds(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0)
+
+	id := authorizeTestTransfer(t, s, accounts[0], accounts[1], 60)
+	hold := transactionDetails(t, s, id)
+	assert.Equal(t, banking.TransactionStatus_TRANSACTION_STATUS_PENDING, hold.Status)
+	assertProtoEqual(t, usd(60), hold.AuthorizedAmount)
+	assert.Nil(t, hold.PostedAt)
+	assert.WithinDuration(t, time.Now().Add(s.HoldTTL), hold.ExpiresAt.AsTime(), time.Minute)
+
+	ledger, available := balances(t, s, accounts[0])
+	assertProtoEqual(t, usd(100), ledger)
+	assertProtoEqual(t, usd(40), available)
+
+	// Held funds cannot be spent, either by transfers or by further holds.
+	_, err := s.AuthorizeTransfer(context.Background(), &banking.AuthorizeTransferRequest{
+		FromAccountId: accounts[0],
+		ToAccountId:   accounts[1],
+		Amount:        usd(50),
+	})
+	assert.Equal(t, ReasonInsufficientFunds, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+	_, err = s.MakeTransaction(context.Background(), &banking.TransactionRequest{
+		FromAccountId: accounts[0],
+		ToAccountId:   accounts[1],
+		Amount:        usd(50),
+	})
+	assert.Equal(t, ReasonInsufficientFunds, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+
+	_, err = s.CloseAccount(context.Background(), &banking.CloseAccountRequest{
+		AccountId:      accounts[0],
+		SweepAccountId: accounts[1],
+	})
+	assert.Equal(t, ReasonAccountNotEmpty, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+}
+
+func TestCaptureTransfer(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0)
+	id := authorizeTestTransfer(t, s, accounts[0], accounts[1], 60)
+
+	_, err := s.CaptureTransfer(context.Background(), &banking.CaptureTransferRequest{TransactionId: id, Amount: usd(61)})
+	assert.Equal(t, ReasonCaptureExceedsAuthorization, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+
+	res, err := s.CaptureTransfer(context.Background(), &banking.CaptureTransferRequest{TransactionId: id, Amount: usd(45)})
+	require.NoError(t, err)
+	assert.Equal(t, id, res.TransactionId)
+
+	tx := transactionDetails(t, s, id)
+	assert.Equal(t, banking.TransactionStatus_TRANSACTION_STATUS_POSTED, tx.Status)
+	assertProtoEqual(t, usd(45), tx.Amount)
+	assertProtoEqual(t, usd(60), tx.AuthorizedAmount)
+	assert.NotNil(t, tx.PostedAt)
+
+	// The uncaptured remainder is released.
+	ledger, available := balances(t, s, accounts[0])
+	assertProtoEqual(t, usd(55), ledger)
+	assertProtoEqual(t, usd(55), available)
+	ledger, _ = balances(t, s, accounts[1])
+	assertProtoEqual(t, usd(45), ledger)
+
+	_, err = s.CaptureTransfer(context.Background(), &banking.CaptureTransferRequest{TransactionId: id})
+	assert.Equal(t, ReasonHoldNotPending, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+	_, err = s.VoidTransfer(context.Background(), &banking.VoidTransferRequest{TransactionId: id})
+	assert.Equal(t, ReasonHoldNotPending, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+}
+
+func TestCaptureTransfer_Idempotent(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0)
+	id := authorizeTestTransfer(t, s, accounts[0], accounts[1], 60)
+
+	req := &banking.CaptureTransferRequest{TransactionId: id, IdempotencyKey: "capture-1"}
+	for i := 0; i < 2; i++ {
+		_, err := s.CaptureTransfer(context.Background(), req)
+		require.NoError(t, err)
+	}
+	ledger, _ := balances(t, s, accounts[1])
+	assertProtoEqual(t, usd(60), ledger)
+}
+
+func TestVoidTransfer(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0)
+	id := authorizeTestTransfer(t, s, accounts[0], accounts[1], 60)
+
+	_, err := s.VoidTransfer(context.Background(), &banking.VoidTransferRequest{TransactionId: id, Reason: "order cancelled"})
+	require.NoError(t, err)
+
+	tx := transactionDetails(t, s, id)
+	assert.Equal(t, banking.TransactionStatus_TRANSACTION_STATUS_VOIDED, tx.Status)
+	assert.Equal(t, "order cancelled", tx.Reason)
+	ledger, available := balances(t, s, accounts[0])
+	assertProtoEqual(t, usd(100), ledger)
+	assertProtoEqual(t, usd(100), available)
+
+	_, err = s.CaptureTransfer(context.Background(), &banking.CaptureTransferRequest{TransactionId: id})
+	assert.Equal(t, ReasonHoldNotPending, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+	_, err = s.ReverseTransaction(context.Background(), &banking.ReverseTransactionRequest{TransactionId: id})
+	assert.Equal(t, ReasonTransactionNotReversible, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+}
+
+func TestCaptureTransfer_Expired(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0)
+	res, err := s.AuthorizeTransfer(context.Background(), &banking.AuthorizeTransferRequest{
+		FromAccountId: accounts[0],
+		ToAccountId:   accounts[1],
+		Amount:        usd(60),
+		Ttl:           durationpb.New(time.Millisecond),
+	})
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+
+	_, err = s.CaptureTransfer(context.Background(), &banking.CaptureTransferRequest{TransactionId: res.TransactionId})
+	assert.Equal(t, ReasonHoldExpired, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+
+	tx := transactionDetails(t, s, res.TransactionId)
+	assert.Equal(t, banking.TransactionStatus_TRANSACTION_STATUS_EXPIRED, tx.Status)
+	_, available := balances(t, s, accounts[0])
+	assertProtoEqual(t, usd(100), available)
+}
+
+func TestAuthorizeTransfer_InvalidTTL(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0)
+
+	_, err := s.AuthorizeTransfer(context.Background(), &banking.AuthorizeTransferRequest{
+		FromAccountId: accounts[0],
+		ToAccountId:   accounts[1],
+		Amount:        usd(60),
+		Ttl:           durationpb.New(-time.Second),
+	})
+	assert.Equal(t, "ttl", errorDetail[*errdetails.BadRequest](t, err).FieldViolations[0].Field)
+}
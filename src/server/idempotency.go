@@ -0,0 +1,202 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const idempotencyKeyField = "idempotencyKey"
+
+// IdempotencyRecord is the remembered result of a request that carried an
+// idempotency key.
+type IdempotencyRecord struct {
+	Key string
+	// Fingerprint identifies the request, so that the key cannot be reused
+	// for a different one.
+	Fingerprint []byte
+	// Response is the marshalled response to the request.
+	Response []byte
+	Expires  time.Time
+}
+
+// idempotencyCache remembers the result of requests that carry an
+// idempotency key so that client retries do not repeat side effects. Only
+// calls that return without an error are remembered; a failed call leaves
+// nothing behind and may be retried with the same key. Results are kept in
+// the store, so that retries are recognised across restarts, and in memory
+// while calls are in progress, so that concurrent retries wait for the
+// first.
+type idempotencyCache struct {
+	mtx       sync.Mutex
+	store     Store
+	logger    func(context.Context) *slog.Logger
+	entries   map[string]*idempotencyEntry
+	nextSweep time.Time
+}
+
+type idempotencyEntry struct {
+	fingerprint []byte
+	// done is closed once the call has completed, with ok set if it
+	// succeeded, or has failed.
+	done     chan struct{}
+	ok       bool
+	response []byte
+	expires  time.Time
+}
+
+// newIdempotencyCache returns a cache keeping results in store, which logs
+// through the logger that logger returns for a call's context.
+func newIdempotencyCache(store Store, logger func(context.Context) *slog.Logger) *idempotencyCache {
+	return &idempotencyCache{store: store, logger: logger, entries: make(map[string]*idempotencyEntry)}
+}
+
+// idempotent runs call unless a request to method with the same key has
+// already completed within ttl, in which case the earlier response is
+// returned. Concurrent requests with the same key wait for the first one.
+// An empty key disables deduplication.
+func idempotent[Req, Res proto.Message](
+	c *idempotencyCache, ctx context.Context, ttl time.Duration,
+	method, key string, req Req, call func() (Res, error),
+) (Res, error) {
+	var zero Res
+	if key == "" {
+		return call()
+	}
+	fingerprint, err := requestFingerprint(req, idempotencyKeyField)
+	if err != nil {
+		return zero, err
+	}
+	idempotencyKey := key
+	key = method + "/" + key
+	// Keys are chosen by clients, so one principal must not be able to
+	// replay, or block, the request of another.
+	if p, ok := PrincipalFromContext(ctx); ok {
+		key = p.Subject + "\x00" + key
+	}
+
+	for {
+		entry, first, err := c.begin(ctx, key, fingerprint)
+		if err != nil {
+			return zero, err
+		}
+		if entry == nil {
+			return zero, newStatus(codes.FailedPrecondition, ReasonIdempotencyKeyReused,
+				IdempotencyKeyReusedError.Error(), map[string]string{"idempotencyKey": idempotencyKey}).Err()
+		}
+		if first {
+			return runIdempotent(c, ctx, key, entry, ttl, call)
+		}
+
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return zero, status.FromContextError(ctx.Err()).Err()
+		}
+		if entry.ok {
+			res := zero.ProtoReflect().New().Interface().(Res)
+			if err := proto.Unmarshal(entry.response, res); err != nil {
+				return zero, err
+			}
+			return res, nil
+		}
+		// The first call failed and was forgotten; try to run it ourselves.
+	}
+}
+
+// runIdempotent makes the call entry was created for and remembers its
+// result. The entry is finished even if call panics, so that requests
+// waiting on it do not wait forever.
+func runIdempotent[Res proto.Message](
+	c *idempotencyCache, ctx context.Context, key string, entry *idempotencyEntry,
+	ttl time.Duration, call func() (Res, error),
+) (res Res, err error) {
+	var response []byte
+	ok := false
+	defer func() { c.finish(ctx, key, entry, response, ok, ttl) }()
+
+	res, err = call()
+	if err == nil {
+		// A response that cannot be marshalled is forgotten, as if the
+		// call had failed.
+		var merr error
+		response, merr = proto.Marshal(res)
+		ok = merr == nil
+	}
+	return res, err
+}
+
+// begin returns the entry for key, loading it from the store or creating it
+// if needed. first is true if the caller created the entry and must run the
+// call. A nil entry means the key is held by a request with a different
+// fingerprint.
+func (c *idempotencyCache) begin(ctx context.Context, key string, fingerprint []byte) (entry *idempotencyEntry, first bool, err error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	now := time.Now()
+	if now.After(c.nextSweep) {
+		for k, e := range c.entries {
+			if !e.expires.IsZero() && now.After(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.nextSweep = now.Add(time.Minute)
+	}
+
+	entry, ok := c.entries[key]
+	if ok && !entry.expires.IsZero() && now.After(entry.expires) {
+		ok = false
+	}
+	if !ok {
+		record, err := c.store.GetIdempotencyRecord(ctx, key)
+		if err != nil {
+			return nil, false, err
+		}
+		if record == nil {
+			entry = &idempotencyEntry{fingerprint: fingerprint, done: make(chan struct{})}
+			c.entries[key] = entry
+			return entry, true, nil
+		}
+		// Completed before the server last started.
+		entry = &idempotencyEntry{fingerprint: record.Fingerprint, done: make(chan struct{}),
+			ok: true, response: record.Response, expires: record.Expires}
+		close(entry.done)
+		c.entries[key] = entry
+	}
+	if !bytes.Equal(entry.fingerprint, fingerprint) {
+		return nil, false, nil
+	}
+	return entry, false, nil
+}
+
+// finish completes entry, storing its response if the call succeeded and
+// forgetting it otherwise.
+func (c *idempotencyCache) finish(ctx context.Context, key string, entry *idempotencyEntry, response []byte, ok bool, ttl time.Duration) {
+	c.mtx.Lock()
+	if !ok {
+		delete(c.entries, key)
+	} else {
+		entry.ok = true
+		entry.response = response
+		entry.expires = time.Now().Add(ttl)
+	}
+	close(entry.done)
+	c.mtx.Unlock()
+
+	if !ok {
+		return
+	}
+	record := &IdempotencyRecord{Key: key, Fingerprint: entry.fingerprint, Response: response, Expires: entry.expires}
+	if err := c.store.PutIdempotencyRecord(ctx, record); err != nil {
+		// The call has succeeded, so its response still stands; only a
+		// retry after a restart would repeat it.
+		c.logger(ctx).ErrorContext(ctx, "Failed to store idempotency record", "error", err)
+	}
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIdempotency_MakeTransactionReplaysFirstResult(t *testing.T) {
+	s := getNewTestServer()
+	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+	ca2, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+	req := &banking.TransactionRequest{
+		FromAccountId:  ca1.AccountId,
+		ToAccountId:    ca2.AccountId,
+		Amount:         usd(10),
+		IdempotencyKey: "key-1",
+	}
+
+	var wg sync.WaitGroup
+	ids := make([]string, 5)
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res, err := s.MakeTransaction(context.Background(), req)
+			assert.NoError(t, err)
+			ids[i] = res.TransactionId
+		}(i)
+	}
+	wg.Wait()
+
+	for _, id := range ids {
+		assert.Equal(t, ids[0], id)
+	}
+	balance, _ := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: ca1.AccountId})
+	assert.Equal(t, int64(90), balance.Balance.Units)
+}
+
+func TestIdempotency_KeyReusedWithDifferentParameters(t *testing.T) {
+	s := getNewTestServer()
+	_, err := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100), IdempotencyKey: "key-1"})
+	assert.NoError(t, err)
+
+	_, err = s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(200), IdempotencyKey: "key-1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestIdempotency_KeysArePerPrincipal(t *testing.T) {
+	s := getNewTestServer()
+	alice := ContextWithPrincipal(context.Background(), &Principal{Subject: "alice"})
+	bob := ContextWithPrincipal(context.Background(), &Principal{Subject: "bob"})
+
+	first, err := s.CreateAccount(alice, &banking.AccountRequest{InitialBalance: usd(100), IdempotencyKey: "key-1"})
+	assert.NoError(t, err)
+	second, err := s.CreateAccount(bob, &banking.AccountRequest{InitialBalance: usd(100), IdempotencyKey: "key-1"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.AccountId, second.AccountId)
+}
+
+func TestIdempotency_FailuresAreNotRemembered(t *testing.T) {
+	s := getNewTestServer()
+	req := &banking.AccountRequest{IdempotencyKey: "key-1"}
+
+	_, err := s.CreateAccount(context.Background(), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	req.InitialBalance = usd(100)
+	_, err = s.CreateAccount(context.Background(), req)
+	assert.NoError(t, err)
+}
+
+func TestIdempotency_Expiry(t *testing.T) {
+	s := getNewTestServer()
+	s.IdempotencyTTL = time.Millisecond
+	req := &banking.AccountRequest{InitialBalance: usd(100), IdempotencyKey: "key-1"}
+
+	res1, _ := s.CreateAccount(context.Background(), req)
+	time.Sleep(5 * time.Millisecond)
+	res2, _ := s.CreateAccount(context.Background(), req)
+
+	assert.NotEqual(t, res1.AccountId, res2.AccountId)
+}
+
+func TestIdempotency_SurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	s := NewServer(openTestWALStore(t, dir))
+	s.TestMode(false)
+	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+	ca2, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+	req := &banking.TransactionRequest{FromAccountId: ca1.AccountId, ToAccountId: ca2.AccountId, Amount: usd(10), IdempotencyKey: "key-1"}
+	first, err := s.MakeTransaction(context.Background(), req)
+	require.NoError(t, err)
+	// Simulate a crash: drop the store without a final snapshot.
+	s.store.(*WALStore).log.Close()
+
+	s = NewServer(openTestWALStore(t, dir))
+	s.TestMode(false)
+	retry, err := s.MakeTransaction(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, first.TransactionId, retry.TransactionId)
+	balance, _ := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: ca1.AccountId})
+	assert.Equal(t, int64(90), balance.Balance.Units)
+
+	req.Amount = usd(20)
+	_, err = s.MakeTransaction(context.Background(), req)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "fingerprint survives too")
+	assert.NoError(t, s.store.Close())
+}
+
+func TestIdempotency_PanicReleasesKey(t *testing.T) {
+	c := newIdempotencyCache(NewMemoryStore(), func(context.Context) *slog.Logger { return discardLogger() })
+	req := &banking.PingRequest{Message: "ping"}
+	call := func() (*banking.PingResponse, error) { panic("boom") }
+	assert.Panics(t, func() { idempotent(c, context.Background(), time.Hour, "Ping", "key-1", req, call) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := idempotent(c, ctx, time.Hour, "Ping", "key-1", req,
+		func() (*banking.PingResponse, error) { return &banking.PingResponse{Message: "Pong"}, nil })
+	require.NoError(t, err)
+	assert.Equal(t, "Pong", res.Message)
+}
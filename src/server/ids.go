@@ -0,0 +1,62 @@
+package server
+
+import (
+	"crypto/rand"
+	"sync"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+)
+
+// IDGenerator produces transaction IDs. Implementations must be safe for
+// concurrent use.
+type IDGenerator interface {
+	NewID() (string, error)
+	// Format is reported to clients alongside every ID so they know whether
+	// IDs can be sorted by creation time.
+	Format() banking.IdFormat
+}
+
+// UUIDv7Generator generates time-ordered version 7 UUIDs. IDs generated
+// within one process are strictly increasing.
+type UUIDv7Generator struct{}
+
+func (UUIDv7Generator) NewID() (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+func (UUIDv7Generator) Format() banking.IdFormat {
+	return banking.IdFormat_ID_FORMAT_UUID_V7
+}
+
+// ULIDGenerator generates time-ordered ULIDs. IDs generated by one
+// generator are strictly increasing.
+type ULIDGenerator struct {
+	mtx     sync.Mutex
+	entropy *ulid.MonotonicEntropy
+}
+
+func NewULIDGenerator() *ULIDGenerator {
+	return &ULIDGenerator{entropy: ulid.Monotonic(rand.Reader, 0)}
+}
+
+func (g *ULIDGenerator) NewID() (string, error) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	id, err := ulid.New(ulid.Timestamp(time.Now()), g.entropy)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+func (g *ULIDGenerator) Format() banking.IdFormat {
+	return banking.IdFormat_ID_FORMAT_ULID
+}
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func TestIDGenerators_Sortable(t *testing.T) {
+	for _, g := range []IDGenerator{UUIDv7Generator{}, NewULIDGenerator()} {
+		prev := ""
+		for i := 0; i < 1000; i++ {
+			id, err := g.NewID()
+			assert.NoError(t, err)
+			assert.Greater(t, id, prev, "%T", g)
+			prev = id
+		}
+	}
+
+	id, _ := UUIDv7Generator{}.NewID()
+	assert.Equal(t, uuid.Version(7), uuid.MustParse(id).Version())
+}
+
+// sequenceIDs hands out a fixed list of IDs.
+type sequenceIDs struct {
+	ids []string
+}
+
+func (g *sequenceIDs) NewID() (string, error) {
+	id := g.ids[0]
+	g.ids = g.ids[1:]
+	return id, nil
+}
+
+func (g *sequenceIDs) Format() banking.IdFormat {
+	return banking.IdFormat_ID_FORMAT_UNSPECIFIED
+}
+
+func TestServer_MakeTransaction_RetriesIDCollision(t *testing.T) {
+	s := getNewTestServer()
+	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+	ca2, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+	req := &banking.TransactionRequest{FromAccountId: ca1.AccountId, ToAccountId: ca2.AccountId, Amount: usd(10)}
+	s.TransactionIDs = &sequenceIDs{ids: []string{"t1", "t1", "t2"}}
+
+	res1, err := s.MakeTransaction(context.Background(), req)
+	assert.NoError(t, err)
+	res2, err := s.MakeTransaction(context.Background(), req)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "t1", res1.TransactionId)
+	assert.Equal(t, "t2", res2.TransactionId)
+	balance, _ := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: ca1.AccountId})
+	assert.Equal(t, int64(80), balance.Balance.Units)
+}
+
+func TestServer_RetriesIDCollisionForEveryRecord(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 100, 0)
+	s.TransactionIDs = &sequenceIDs{ids: []string{"t1", "e1", "e1", "e2", "t1", "t2", "t1", "t1", "t1"}}
+
+	tx, err := s.MakeTransaction(context.Background(), &banking.TransactionRequest{FromAccountId: accounts[0], ToAccountId: accounts[1], Amount: usd(10)})
+	assert.NoError(t, err)
+	assert.Equal(t, "t1", tx.TransactionId)
+	legs := []*banking.JournalLeg{{AccountId: accounts[0], Amount: usd(-10)}, {AccountId: accounts[1], Amount: usd(10)}}
+	for _, want := range []string{"e1", "e2"} {
+		entry, err := s.PostJournalEntry(context.Background(), &banking.JournalEntryRequest{Legs: legs})
+		assert.NoError(t, err)
+		assert.Equal(t, want, entry.EntryId)
+	}
+	closed, err := s.CloseAccount(context.Background(), &banking.CloseAccountRequest{AccountId: accounts[0], SweepAccountId: accounts[1]})
+	assert.NoError(t, err)
+	assert.Equal(t, "t2", closed.SweepTransactionId)
+
+	// Only so many IDs are tried.
+	_, err = s.MakeTransaction(context.Background(), &banking.TransactionRequest{FromAccountId: accounts[1], ToAccountId: accounts[2], Amount: usd(10)})
+	assert.Equal(t, ReasonTransactionExists, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+}
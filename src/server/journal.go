@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) PostJournalEntry(ctx context.Context, req *banking.JournalEntryRequest) (*banking.JournalEntryResponse, error) {
+	return idempotent(s.idempotency, ctx, s.IdempotencyTTL, "PostJournalEntry", req.IdempotencyKey, req,
+		func() (*banking.JournalEntryResponse, error) { return s.postJournalEntry(ctx, req) })
+}
+
+func (s *Server) postJournalEntry(ctx context.Context, req *banking.JournalEntryRequest) (*banking.JournalEntryResponse, error) {
+	if violations := checkJournalLegs(req.Legs); len(violations) > 0 {
+		return nil, invalidArgument(violations...)
+	}
+
+	entry := &banking.JournalEntry{
+		Legs:      req.Legs,
+		CreatedAt: timestamppb.Now(),
+	}
+	if err := s.postEntry(ctx, entry); err != nil {
+		return nil, statusError(err)
+	}
+
+	logger := s.logger(ctx)
+	for _, leg := range entry.Legs {
+		logger.DebugContext(ctx, "Posted journal leg", "entryId", entry.EntryId, "accountId", leg.AccountId, "amount", formatMoney(leg.Amount))
+	}
+
+	return &banking.JournalEntryResponse{EntryId: entry.EntryId, IdFormat: entry.IdFormat}, nil
+}
+
+// checkJournalLegs reports legs that are zero, and entries that have fewer
+// than two legs or do not balance in every currency.
+func checkJournalLegs(legs []*banking.JournalLeg) []FieldViolation {
+	var violations []FieldViolation
+	if len(legs) < 2 {
+		violations = append(violations, FieldViolation{"legs", "must have at least two legs"})
+	}
+	totals := make(map[string]*big.Int)
+	var currencies []string
+	for i, leg := range legs {
+		field := fmt.Sprintf("legs[%d].amount", i)
+		if err := validateMoney(leg.Amount); err != nil {
+			violations = append(violations, FieldViolation{field, err.Error()})
+			continue
+		}
+		nanos := moneyNanos(leg.Amount)
+		if nanos.Sign() == 0 {
+			violations = append(violations, FieldViolation{field, "must not be zero"})
+		}
+		if totals[leg.Amount.CurrencyCode] == nil {
+			totals[leg.Amount.CurrencyCode] = new(big.Int)
+			currencies = append(currencies, leg.Amount.CurrencyCode)
+		}
+		totals[leg.Amount.CurrencyCode].Add(totals[leg.Amount.CurrencyCode], nanos)
+	}
+	for _, currency := range currencies {
+		if totals[currency].Sign() != 0 {
+			violations = append(violations, FieldViolation{"legs", "must sum to zero in " + currency})
+		}
+	}
+	return violations
+}
+
+// postEntry assigns entry a fresh ID and posts it, retrying on collisions
+// as transfer does.
+func (s *Server) postEntry(ctx context.Context, entry *banking.JournalEntry) error {
+	return s.withFreshID(JournalEntryExistsError, func(id string, format banking.IdFormat) error {
+		entry.EntryId, entry.IdFormat = id, format
+		return s.store.PostJournalEntry(ctx, entry)
+	})
+}
+
+func (s *Server) ListJournalEntries(ctx context.Context, req *banking.ListJournalEntriesRequest) (*banking.ListJournalEntriesResponse, error) {
+	res, err := listJournalEntries(ctx, s.store, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger(ctx).DebugContext(ctx, "Listed journal entries", "accountId", req.AccountId, "journalEntries", len(res.JournalEntries))
+
+	return res, nil
+}
+
+// journalEntriesByCreatedAt orders journal entries by creation time,
+// breaking ties by ID.
+var journalEntriesByCreatedAt = Ordering[*banking.JournalEntry]{
+	Name: "createdAt",
+	Key:  func(entry *banking.JournalEntry) []byte { return timeKey(entry.CreatedAt) },
+	ID:   func(entry *banking.JournalEntry) string { return entry.EntryId },
+}
+
+// journalEntryOrdering parses orderBy into an ordering of journal entries.
+// It returns false if orderBy is not recognised.
+func journalEntryOrdering(orderBy string) (Ordering[*banking.JournalEntry], bool) {
+	field, desc := parseOrderBy(orderBy, "createdAt")
+	order := journalEntriesByCreatedAt
+	order.Desc = desc
+	return order, field == "createdAt"
+}
+
+// matchJournalEntry reports whether entry matches every filter in req.
+func matchJournalEntry(entry *banking.JournalEntry, req *banking.ListJournalEntriesRequest) bool {
+	switch {
+	case req.AccountId != "" && !hasLeg(entry, req.AccountId):
+		return false
+	case req.StartTime != nil && entry.CreatedAt.AsTime().Before(req.StartTime.AsTime()):
+		return false
+	case req.EndTime != nil && !entry.CreatedAt.AsTime().Before(req.EndTime.AsTime()):
+		return false
+	}
+	return true
+}
+
+// hasLeg reports whether entry posts to the account with the given ID.
+func hasLeg(entry *banking.JournalEntry, accountID string) bool {
+	for _, leg := range entry.Legs {
+		if leg.AccountId == accountID {
+			return true
+		}
+	}
+	return false
+}
+
+// listJournalEntries applies the filters, ordering and pagination of req
+// to the journal entries in store.
+func listJournalEntries(ctx context.Context, store Store, req *banking.ListJournalEntriesRequest) (*banking.ListJournalEntriesResponse, error) {
+	order, ok := journalEntryOrdering(req.OrderBy)
+	if !ok {
+		return nil, invalidArgument(FieldViolation{"orderBy", "must be createdAt, optionally followed by desc"})
+	}
+
+	match := func(entry *banking.JournalEntry) bool { return matchJournalEntry(entry, req) }
+	page, next, err := listPage(ctx, req, order, match, store.ScanJournalEntries)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &banking.ListJournalEntriesResponse{JournalEntries: page, NextPageToken: next}, nil
+}
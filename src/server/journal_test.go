@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPostJournalEntry(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 1000, 0, 0)
+
+	res, err := s.PostJournalEntry(context.Background(), &banking.JournalEntryRequest{
+		Legs: []*banking.JournalLeg{
+			{AccountId: accounts[0], Amount: usd(-300)},
+			{AccountId: accounts[1], Amount: usd(200)},
+			{AccountId: accounts[2], Amount: usd(100)},
+		},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, res.EntryId)
+	assert.Equal(t, banking.IdFormat_ID_FORMAT_UUID_V7, res.IdFormat)
+
+	for i, want := range []int64{700, 200, 100} {
+		balance, err := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: accounts[i]})
+		require.NoError(t, err)
+		assertProtoEqual(t, usd(want), balance.Balance)
+	}
+}
+
+func TestPostJournalEntry_IsAtomic(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0, 0)
+
+	_, err := s.PostJournalEntry(context.Background(), &banking.JournalEntryRequest{
+		Legs: []*banking.JournalLeg{
+			{AccountId: accounts[1], Amount: usd(150)},
+			{AccountId: accounts[0], Amount: usd(-150)},
+		},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	balance, err := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: accounts[1]})
+	require.NoError(t, err)
+	assertProtoEqual(t, usd(0), balance.Balance)
+}
+
+func TestPostJournalEntry_InvalidLegs(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0)
+
+	_, err := s.PostJournalEntry(context.Background(), &banking.JournalEntryRequest{
+		Legs: []*banking.JournalLeg{
+			{AccountId: accounts[0], Amount: usd(-10)},
+			{AccountId: accounts[1], Amount: usd(0)},
+		},
+	})
+	fields := violatedFields(t, err)
+	assert.Len(t, fields, 2)
+	assert.Contains(t, fields, "legs[1].amount")
+	assert.Equal(t, "must sum to zero in USD", fields["legs"])
+
+	_, err = s.PostJournalEntry(context.Background(), &banking.JournalEntryRequest{
+		Legs: []*banking.JournalLeg{{AccountId: accounts[0], Amount: usd(10)}},
+	})
+	assert.Contains(t, violatedFields(t, err), "legs")
+}
+
+func TestListJournalEntries(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 1000, 0, 0)
+	makeTestTransfers(t, s, accounts[0], accounts[1], 50)
+	var ids []string
+	for _, amount := range []int64{300, 20} {
+		res, err := s.PostJournalEntry(context.Background(), &banking.JournalEntryRequest{
+			Legs: []*banking.JournalLeg{
+				{AccountId: accounts[0], Amount: usd(-amount)},
+				{AccountId: accounts[2], Amount: usd(amount)},
+			},
+		})
+		require.NoError(t, err)
+		ids = append(ids, res.EntryId)
+	}
+
+	res, err := s.ListJournalEntries(context.Background(), &banking.ListJournalEntriesRequest{AccountId: accounts[0], PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, res.JournalEntries, 1)
+	assert.Equal(t, ids[0], res.JournalEntries[0].EntryId)
+	res, err = s.ListJournalEntries(context.Background(), &banking.ListJournalEntriesRequest{AccountId: accounts[0], PageSize: 1, PageToken: res.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, res.JournalEntries, 1)
+	assert.Equal(t, ids[1], res.JournalEntries[0].EntryId)
+	assert.Empty(t, res.NextPageToken)
+
+	res, err = s.ListJournalEntries(context.Background(), &banking.ListJournalEntriesRequest{AccountId: accounts[1]})
+	require.NoError(t, err)
+	assert.Empty(t, res.JournalEntries)
+
+	// The transactions and journal entries of an account add up to its
+	// balance.
+	total := int64(1000)
+	txs, err := s.ListTransactions(context.Background(), &banking.ListTransactionsRequest{AccountId: accounts[0]})
+	require.NoError(t, err)
+	for _, tx := range txs.Transactions {
+		total -= tx.Amount.Units
+	}
+	entries, err := s.ListJournalEntries(context.Background(), &banking.ListJournalEntriesRequest{AccountId: accounts[0]})
+	require.NoError(t, err)
+	for _, entry := range entries.JournalEntries {
+		for _, leg := range entry.Legs {
+			if leg.AccountId == accounts[0] {
+				total += leg.Amount.Units
+			}
+		}
+	}
+	balance, err := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: accounts[0]})
+	require.NoError(t, err)
+	assert.Equal(t, balance.Balance.Units, total)
+}
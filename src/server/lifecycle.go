@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+)
+
+func (s *Server) FreezeAccount(ctx context.Context, req *banking.AccountStatusRequest) (*banking.AccountStatusResponse, error) {
+	return s.setAccountStatus(ctx, req.AccountId, banking.AccountStatus_ACCOUNT_STATUS_FROZEN, req.Reason)
+}
+
+func (s *Server) UnfreezeAccount(ctx context.Context, req *banking.AccountStatusRequest) (*banking.AccountStatusResponse, error) {
+	return s.setAccountStatus(ctx, req.AccountId, banking.AccountStatus_ACCOUNT_STATUS_ACTIVE, req.Reason)
+}
+
+func (s *Server) setAccountStatus(ctx context.Context, id string, status banking.AccountStatus, reason string) (*banking.AccountStatusResponse, error) {
+	account, err := s.store.SetAccountStatus(ctx, id, status, reason, nil)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	s.logger(ctx).DebugContext(ctx, "Set account status", "accountId", id, "status", status.String(), "reason", reason)
+
+	return &banking.AccountStatusResponse{Account: account}, nil
+}
+
+func (s *Server) CloseAccount(ctx context.Context, req *banking.CloseAccountRequest) (*banking.AccountStatusResponse, error) {
+	account, err := s.store.GetAccount(ctx, req.AccountId)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	if err := checkCanClose(account); err != nil {
+		return nil, statusError(err)
+	}
+
+	var sweep *banking.Transaction
+	if req.SweepAccountId != "" && moneyNanos(account.Balance).Sign() > 0 {
+		sweep, err = s.newTransaction(ctx, &banking.TransactionRequest{
+			FromAccountId: req.AccountId,
+			ToAccountId:   req.SweepAccountId,
+			Amount:        account.Balance,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var closed *banking.Account
+	closeAccount := func(ctx context.Context, sweep *banking.Transaction) (err error) {
+		closed, err = s.store.SetAccountStatus(ctx, req.AccountId, banking.AccountStatus_ACCOUNT_STATUS_CLOSED, req.Reason, sweep)
+		return err
+	}
+	if sweep != nil {
+		err = s.post(ctx, sweep, closeAccount)
+	} else {
+		err = closeAccount(ctx, nil)
+	}
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	res := &banking.AccountStatusResponse{Account: closed}
+	if sweep != nil {
+		res.SweepTransactionId = sweep.TransactionId
+		s.transactionPosted(ctx, sweep)
+	}
+
+	s.logger(ctx).DebugContext(ctx, "Closed account", "accountId", req.AccountId, "reason", req.Reason)
+
+	return res, nil
+}
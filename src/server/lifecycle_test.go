@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func transferReason(t *testing.T, s *Server, from, to string) string {
+	_, err := s.MakeTransaction(context.Background(), &banking.TransactionRequest{
+		FromAccountId: from,
+		ToAccountId:   to,
+		Amount:        usd(1),
+	})
+	if err == nil {
+		return ""
+	}
+	return errorDetail[*errdetails.ErrorInfo](t, err).Reason
+}
+
+func TestFreezeAccount(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 100)
+
+	res, err := s.FreezeAccount(context.Background(), &banking.AccountStatusRequest{
+		AccountId: accounts[0],
+		Reason:    "compliance hold",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, banking.AccountStatus_ACCOUNT_STATUS_FROZEN, res.Account.Status)
+	assert.Equal(t, "compliance hold", res.Account.StatusReason)
+
+	assert.Equal(t, ReasonAccountFrozen, transferReason(t, s, accounts[0], accounts[1]))
+	assert.Empty(t, transferReason(t, s, accounts[1], accounts[0]))
+
+	_, err = s.UnfreezeAccount(context.Background(), &banking.AccountStatusRequest{AccountId: accounts[0]})
+	require.NoError(t, err)
+	assert.Empty(t, transferReason(t, s, accounts[0], accounts[1]))
+}
+
+func TestCloseAccount(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 100)
+
+	_, err := s.CloseAccount(context.Background(), &banking.CloseAccountRequest{AccountId: accounts[0]})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, ReasonAccountNotEmpty, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+
+	res, err := s.CloseAccount(context.Background(), &banking.CloseAccountRequest{
+		AccountId:      accounts[0],
+		SweepAccountId: accounts[1],
+		Reason:         "customer request",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, banking.AccountStatus_ACCOUNT_STATUS_CLOSED, res.Account.Status)
+	assertProtoEqual(t, usd(0), res.Account.Balance)
+	sweep := transactionDetails(t, s, res.SweepTransactionId)
+	assertProtoEqual(t, usd(100), sweep.Amount)
+
+	assert.Equal(t, ReasonAccountClosed, transferReason(t, s, accounts[0], accounts[1]))
+	assert.Equal(t, ReasonAccountClosed, transferReason(t, s, accounts[1], accounts[0]))
+
+	_, err = s.UnfreezeAccount(context.Background(), &banking.AccountStatusRequest{AccountId: accounts[0]})
+	assert.Equal(t, ReasonAccountClosed, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+}
+
+func TestCloseAccount_SweepFailsAtomically(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0)
+	_, err := s.CloseAccount(context.Background(), &banking.CloseAccountRequest{AccountId: accounts[1]})
+	require.NoError(t, err)
+
+	_, err = s.CloseAccount(context.Background(), &banking.CloseAccountRequest{
+		AccountId:      accounts[0],
+		SweepAccountId: accounts[1],
+	})
+	assert.Equal(t, ReasonAccountClosed, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+
+	account, err := s.store.GetAccount(context.Background(), accounts[0])
+	require.NoError(t, err)
+	assert.Equal(t, banking.AccountStatus_ACCOUNT_STATUS_ACTIVE, account.Status)
+	assertProtoEqual(t, usd(100), account.Balance)
+}
+
+func TestCloseAccount_Frozen(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 100, 0)
+	for _, id := range []string{accounts[0], accounts[2]} {
+		_, err := s.FreezeAccount(context.Background(), &banking.AccountStatusRequest{AccountId: id})
+		require.NoError(t, err)
+	}
+
+	// A frozen balance cannot be swept out to close the account.
+	_, err := s.CloseAccount(context.Background(), &banking.CloseAccountRequest{
+		AccountId:      accounts[0],
+		SweepAccountId: accounts[1],
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, ReasonAccountFrozen, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+	assert.Contains(t, status.Convert(err).Message(), "unfreeze it")
+	assert.Equal(t, accounts[0], errorDetail[*errdetails.ResourceInfo](t, err).ResourceName)
+	account, err := s.store.GetAccount(context.Background(), accounts[0])
+	require.NoError(t, err)
+	assert.Equal(t, banking.AccountStatus_ACCOUNT_STATUS_FROZEN, account.Status)
+	assertProtoEqual(t, usd(100), account.Balance)
+
+	// The store refuses it too, should the account be frozen after it was
+	// read.
+	_, err = s.store.SetAccountStatus(context.Background(), accounts[0], banking.AccountStatus_ACCOUNT_STATUS_CLOSED, "",
+		&banking.Transaction{TransactionId: "sweep", FromAccountId: accounts[0], ToAccountId: accounts[1], Amount: usd(100)})
+	assert.ErrorIs(t, err, AccountFrozenError)
+
+	// Nor can an empty frozen account be closed: the freeze must be lifted
+	// first.
+	_, err = s.CloseAccount(context.Background(), &banking.CloseAccountRequest{AccountId: accounts[2]})
+	assert.Equal(t, ReasonAccountFrozen, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+	_, err = s.store.SetAccountStatus(context.Background(), accounts[2], banking.AccountStatus_ACCOUNT_STATUS_CLOSED, "", nil)
+	assert.ErrorIs(t, err, AccountFrozenError)
+	account, err = s.store.GetAccount(context.Background(), accounts[2])
+	require.NoError(t, err)
+	assert.Equal(t, banking.AccountStatus_ACCOUNT_STATUS_FROZEN, account.Status)
+
+	for _, id := range []string{accounts[0], accounts[2]} {
+		_, err = s.UnfreezeAccount(context.Background(), &banking.AccountStatusRequest{AccountId: id})
+		require.NoError(t, err)
+	}
+	res, err := s.CloseAccount(context.Background(), &banking.CloseAccountRequest{
+		AccountId:      accounts[0],
+		SweepAccountId: accounts[1],
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, res.SweepTransactionId)
+	_, err = s.CloseAccount(context.Background(), &banking.CloseAccountRequest{AccountId: accounts[2]})
+	assert.NoError(t, err)
+}
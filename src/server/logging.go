@@ -0,0 +1,181 @@
+package server
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDHeader is the metadata key a request ID is read from and echoed
+// back in. Calls without one are given a fresh ID.
+const RequestIDHeader = "x-request-id"
+
+// maxRequestIDLen bounds the request IDs accepted from clients.
+const maxRequestIDLen = 128
+
+var logLevelNames = map[string]slog.Level{
+	"debug": slog.LevelDebug,
+	"info":  slog.LevelInfo,
+	"warn":  slog.LevelWarn,
+	"error": slog.LevelError,
+}
+
+// Log attributes holding account IDs are masked, and those holding amounts
+// dropped, by loggers that do not log at debug level.
+var (
+	accountIDLogKeys = map[string]bool{
+		"accountId": true, "accountIds": true, "fromAccountId": true, "toAccountId": true, "sweepAccountId": true,
+	}
+	amountLogKeys = map[string]bool{
+		"amount": true, "balance": true, "availableBalance": true, "creditAmount": true, "initialBalance": true,
+	}
+)
+
+// NewLogger returns a logger writing records at level or above to w, as
+// JSON unless format is "text". Unless level is debug, account IDs are
+// masked down to their last four characters and amounts are redacted.
+func NewLogger(w io.Writer, level slog.Level, format string) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: level}
+	if level > slog.LevelDebug {
+		opts.ReplaceAttr = redactAttr
+	}
+	if format == "text" {
+		return slog.New(slog.NewTextHandler(w, opts))
+	}
+	return slog.New(slog.NewJSONHandler(w, opts))
+}
+
+func redactAttr(groups []string, a slog.Attr) slog.Attr {
+	switch {
+	case accountIDLogKeys[a.Key]:
+		switch v := a.Value.Any().(type) {
+		case string:
+			a.Value = slog.StringValue(maskID(v))
+		case []string:
+			masked := make([]string, len(v))
+			for i, id := range v {
+				masked[i] = maskID(id)
+			}
+			a.Value = slog.AnyValue(masked)
+		}
+	case amountLogKeys[a.Key]:
+		a.Value = slog.StringValue("[redacted]")
+	}
+	return a
+}
+
+// maskID keeps only the last four characters of id, which is enough to
+// tell accounts apart in logs without identifying them.
+func maskID(id string) string {
+	if len(id) <= 4 {
+		return strings.Repeat("*", len(id))
+	}
+	return "****" + id[len(id)-4:]
+}
+
+// discardLogger drops everything, for tests.
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError + 1}))
+}
+
+type loggerKey struct{}
+
+// logger returns the logger for the call ctx belongs to, which tags every
+// record with its request ID, or Logger outside of calls.
+func (s *Server) logger(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return s.Logger
+}
+
+// requestID returns the request ID the client sent, or a new one if it
+// sent none or one that is unreasonable to log.
+func requestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ids := md.Get(RequestIDHeader); len(ids) > 0 {
+		id := ids[0]
+		printable := strings.IndexFunc(id, func(r rune) bool { return !unicode.IsPrint(r) }) < 0
+		if id != "" && len(id) <= maxRequestIDLen && printable {
+			return id
+		}
+	}
+	return uuid.NewString()
+}
+
+// startCall tags ctx with a logger carrying the call's request ID, and its
+// trace ID if it is being traced, and returns the request ID for echoing
+// back to the client.
+func (s *Server) startCall(ctx context.Context) (context.Context, string) {
+	id := requestID(ctx)
+	logger := s.Logger.With("requestId", id)
+	if sc := trace.SpanContextFromContext(ctx); sc.IsSampled() {
+		logger = logger.With("traceId", sc.TraceID().String())
+	}
+	return context.WithValue(ctx, loggerKey{}, logger), id
+}
+
+// logCall records the outcome of a call: errors the server is at fault for
+// at error level, those the client is at info level alongside successes.
+// Messages of client errors name accounts and amounts, so below debug level
+// only their reason is logged.
+func (s *Server) logCall(ctx context.Context, method string, start time.Time, err error) {
+	logger := s.logger(ctx)
+	st := status.Convert(err)
+	level := slog.LevelInfo
+	switch st.Code() {
+	case codes.Unknown, codes.Internal, codes.DataLoss, codes.Unimplemented, codes.Unavailable:
+		level = slog.LevelError
+	}
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("code", st.Code().String()),
+		slog.Duration("latency", time.Since(start)),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		attrs = append(attrs, slog.String("peer", p.Addr.String()))
+	}
+	if err != nil {
+		for _, detail := range st.Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok {
+				attrs = append(attrs, slog.String("reason", info.Reason))
+			}
+		}
+		if level == slog.LevelError || logger.Enabled(ctx, slog.LevelDebug) {
+			attrs = append(attrs, slog.String("error", st.Message()))
+		}
+	}
+	logger.LogAttrs(ctx, level, "Call finished", attrs...)
+}
+
+// loggingUnaryInterceptor logs every call once it has finished, and gives
+// the handler a logger tagged with the call's request ID.
+func (s *Server) loggingUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	ctx, id := s.startCall(ctx)
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id))
+	res, err := handler(ctx, req)
+	s.logCall(ctx, info.FullMethod, start, err)
+	return res, err
+}
+
+func (s *Server) loggingStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	ctx, id := s.startCall(ss.Context())
+	ss.SetHeader(metadata.Pairs(RequestIDHeader, id))
+	err := handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	s.logCall(ctx, info.FullMethod, start, err)
+	return err
+}
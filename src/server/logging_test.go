@@ -0,0 +1,110 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// logBuffer collects the log output of a running server.
+type logBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *logBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// logRecords decodes the JSON records written to w.
+func logRecords(t *testing.T, w fmt.Stringer) []map[string]any {
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(w.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestNewLogger_Redaction(t *testing.T) {
+	id := "6f1c2d3e-aaaa-bbbb-cccc-0123456789ab"
+	log := func(level slog.Level) map[string]any {
+		var buf bytes.Buffer
+		NewLogger(&buf, level, "json").Info("Posted transaction",
+			"fromAccountId", id, "accountIds", []string{id, "abc"}, "amount", "10 USD", "transactionId", "t1")
+		return logRecords(t, &buf)[0]
+	}
+
+	record := log(slog.LevelInfo)
+	assert.Equal(t, "****89ab", record["fromAccountId"])
+	assert.Equal(t, []any{"****89ab", "***"}, record["accountIds"])
+	assert.Equal(t, "[redacted]", record["amount"])
+	assert.Equal(t, "t1", record["transactionId"])
+
+	record = log(slog.LevelDebug)
+	assert.Equal(t, id, record["fromAccountId"])
+	assert.Equal(t, "10 USD", record["amount"])
+}
+
+func TestServer_RequestIDs(t *testing.T) {
+	var buf logBuffer
+	s := getNewTestServer()
+	s.Logger = NewLogger(&buf, slog.LevelInfo, "json")
+	addr := startTestServer(t, s)
+
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := banking.NewBankingServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var header metadata.MD
+	_, err = client.Ping(metadata.AppendToOutgoingContext(ctx, RequestIDHeader, "req-1"), &banking.PingRequest{Message: "ping"}, grpc.Header(&header))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"req-1"}, header.Get(RequestIDHeader))
+
+	_, err = client.GetBalance(ctx, &banking.BalanceRequest{AccountId: "00000000-0000-0000-0000-000000000000"}, grpc.Header(&header))
+	require.Error(t, err)
+	generated := header.Get(RequestIDHeader)
+	require.Len(t, generated, 1)
+	assert.NotEqual(t, "req-1", generated[0])
+
+	records := logRecords(t, &buf)
+	require.Len(t, records, 2)
+	assert.Equal(t, "req-1", records[0]["requestId"])
+	assert.Equal(t, "/banking.BankingService/Ping", records[0]["method"])
+	assert.Equal(t, "OK", records[0]["code"])
+	assert.Contains(t, records[0], "latency")
+	assert.Contains(t, records[0], "peer")
+
+	assert.Equal(t, generated[0], records[1]["requestId"])
+	assert.Equal(t, "NotFound", records[1]["code"])
+	assert.Equal(t, ReasonAccountNotFound, records[1]["reason"])
+	assert.NotContains(t, records[1], "error", "client error messages name accounts")
+}
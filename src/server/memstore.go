@@ -0,0 +1,926 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// changeSet is the full new state of every record touched by one atomic
+// store operation.
+type changeSet struct {
+	accounts       []*banking.Account
+	transactions   []*banking.Transaction
+	journalEntries []*banking.JournalEntry
+	schedules      []*banking.Schedule
+	idempotency    []*IdempotencyRecord
+}
+
+// MemoryStore is a Store that keeps all state in process memory. Its
+// contents are lost when the process exits.
+type MemoryStore struct {
+	mtx            sync.Mutex
+	accounts       map[string]*banking.Account
+	transactions   map[string]*banking.Transaction
+	journalEntries map[string]*banking.JournalEntry
+	schedules      map[string]*banking.Schedule
+	idempotency    map[string]*IdempotencyRecord
+	// The indexes keep each type of record in the orders it is most often
+	// listed in.
+	accountIndexes      recordIndexes[*banking.Account]
+	transactionIndexes  recordIndexes[*banking.Transaction]
+	journalEntryIndexes recordIndexes[*banking.JournalEntry]
+	scheduleIndexes     recordIndexes[*banking.Schedule]
+	// nextIdempotencySweep is when expired idempotency records are next
+	// dropped.
+	nextIdempotencySweep time.Time
+	// commit, when set, is called with the lock held for every change set
+	// before it is applied. If it returns an error the change is dropped.
+	commit   func(cs *changeSet) error
+	onCommit []func(cause proto.Message, accounts []*banking.Account)
+	onStatus []func(before, after *banking.Account)
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		accounts:       make(map[string]*banking.Account),
+		transactions:   make(map[string]*banking.Transaction),
+		journalEntries: make(map[string]*banking.JournalEntry),
+		schedules:      make(map[string]*banking.Schedule),
+		idempotency:    make(map[string]*IdempotencyRecord),
+
+		accountIndexes:      newRecordIndexes(accountsByCreatedAt, accountsByID),
+		transactionIndexes:  newRecordIndexes(transactionsByCreatedAt, holdsByExpiry),
+		journalEntryIndexes: newRecordIndexes(journalEntriesByCreatedAt),
+		scheduleIndexes:     newRecordIndexes(schedulesByCreatedAt, schedulesByNextRun),
+	}
+}
+
+func (m *MemoryStore) Open() error {
+	return nil
+}
+
+func (m *MemoryStore) Close() error {
+	return nil
+}
+
+// lock starts a span for the store operation op and locks mtx, tracing the
+// wait for it separately so that contention shows up in traces. The
+// returned func unlocks mtx and ends the span.
+func (m *MemoryStore) lock(ctx context.Context, op string) func() {
+	ctx, span := tracer.Start(ctx, "store."+op)
+	_, wait := tracer.Start(ctx, "store.lock")
+	m.mtx.Lock()
+	wait.End()
+	return func() {
+		m.mtx.Unlock()
+		span.End()
+	}
+}
+
+// apply commits cs and then writes it into the maps and indexes. Callers
+// must hold mtx and must not retain the messages in cs.
+func (m *MemoryStore) apply(cs *changeSet) error {
+	if m.commit != nil {
+		if err := m.commit(cs); err != nil {
+			return err
+		}
+	}
+	for _, account := range cs.accounts {
+		before, ok := m.accounts[account.Id]
+		m.accounts[account.Id] = account
+		m.accountIndexes.update(before, account, ok)
+		if !ok {
+			before = nil
+		} else if before.Status == account.Status {
+			continue
+		}
+		for _, fn := range m.onStatus {
+			fn(before, account)
+		}
+	}
+	for _, transaction := range cs.transactions {
+		before, ok := m.transactions[transaction.TransactionId]
+		m.transactions[transaction.TransactionId] = transaction
+		m.transactionIndexes.update(before, transaction, ok)
+	}
+	for _, entry := range cs.journalEntries {
+		before, ok := m.journalEntries[entry.EntryId]
+		m.journalEntries[entry.EntryId] = entry
+		m.journalEntryIndexes.update(before, entry, ok)
+	}
+	for _, schedule := range cs.schedules {
+		before, ok := m.schedules[schedule.ScheduleId]
+		m.schedules[schedule.ScheduleId] = schedule
+		m.scheduleIndexes.update(before, schedule, ok)
+	}
+	for _, record := range cs.idempotency {
+		m.idempotency[record.Key] = record
+	}
+	return nil
+}
+
+// reindex rebuilds every index from the maps, for use after they have been
+// written directly. Callers must hold mtx.
+func (m *MemoryStore) reindex() {
+	m.accountIndexes.rebuild(m.accounts)
+	m.transactionIndexes.rebuild(m.transactions)
+	m.journalEntryIndexes.rebuild(m.journalEntries)
+	m.scheduleIndexes.rebuild(m.schedules)
+}
+
+func (m *MemoryStore) CreateAccount(ctx context.Context, account *banking.Account) error {
+	defer m.lock(ctx, "CreateAccount")()
+
+	if _, ok := m.accounts[account.Id]; ok {
+		return &ResourceError{Err: AccountExistsError, ResourceType: accountResourceType, Name: account.Id}
+	}
+	return m.apply(&changeSet{
+		accounts: []*banking.Account{proto.Clone(account).(*banking.Account)},
+	})
+}
+
+func (m *MemoryStore) GetAccount(ctx context.Context, id string) (*banking.Account, error) {
+	defer m.lock(ctx, "GetAccount")()
+
+	account, ok := m.accounts[id]
+	if !ok {
+		return nil, accountNotFound(id)
+	}
+	return proto.Clone(account).(*banking.Account), nil
+}
+
+func (m *MemoryStore) ListAccounts(ctx context.Context) ([]*banking.Account, error) {
+	defer m.lock(ctx, "ListAccounts")()
+
+	accountList := make([]*banking.Account, 0, len(m.accounts))
+	for _, account := range m.accounts {
+		accountList = append(accountList, proto.Clone(account).(*banking.Account))
+	}
+	return accountList, nil
+}
+
+func (m *MemoryStore) ScanAccounts(ctx context.Context, order Ordering[*banking.Account], after *Cursor, fn func(account *banking.Account) bool) error {
+	defer m.lock(ctx, "ScanAccounts")()
+
+	scanRecords(m.accounts, m.accountIndexes, order, after, fn)
+	return nil
+}
+
+func (m *MemoryStore) GetTransaction(ctx context.Context, id string) (*banking.Transaction, error) {
+	defer m.lock(ctx, "GetTransaction")()
+
+	transaction, ok := m.transactions[id]
+	if !ok {
+		return nil, transactionNotFound(id)
+	}
+	return proto.Clone(transaction).(*banking.Transaction), nil
+}
+
+func (m *MemoryStore) ScanTransactions(ctx context.Context, order Ordering[*banking.Transaction], after *Cursor, fn func(tx *banking.Transaction) bool) error {
+	defer m.lock(ctx, "ScanTransactions")()
+
+	scanRecords(m.transactions, m.transactionIndexes, order, after, fn)
+	return nil
+}
+
+func (m *MemoryStore) Transfer(ctx context.Context, tx *banking.Transaction) error {
+	return m.TransferBatch(ctx, []*banking.Transaction{tx})[0]
+}
+
+func (m *MemoryStore) TransferBatch(ctx context.Context, txs []*banking.Transaction) []error {
+	defer m.lock(ctx, "TransferBatch")()
+
+	errs := make([]error, len(txs))
+	b := newTransferBatch(m)
+	var posted []int
+	for i, tx := range txs {
+		if errs[i] = b.add(tx); errs[i] == nil {
+			posted = append(posted, i)
+		}
+	}
+	if len(posted) == 0 {
+		return errs
+	}
+	if err := b.commit(); err != nil {
+		for _, i := range posted {
+			errs[i] = err
+		}
+	}
+	return errs
+}
+
+func (m *MemoryStore) RecordFailedTransfer(ctx context.Context, tx *banking.Transaction) error {
+	defer m.lock(ctx, "RecordFailedTransfer")()
+
+	if _, ok := m.transactions[tx.TransactionId]; ok {
+		return &ResourceError{Err: TransactionExistsError, ResourceType: transactionResourceType, Name: tx.TransactionId}
+	}
+	return m.apply(&changeSet{
+		transactions: []*banking.Transaction{proto.Clone(tx).(*banking.Transaction)},
+	})
+}
+
+func (m *MemoryStore) UpdateAccount(ctx context.Context, id string, update func(account *banking.Account) error) (*banking.Account, error) {
+	defer m.lock(ctx, "UpdateAccount")()
+
+	account, ok := m.accounts[id]
+	if !ok {
+		return nil, accountNotFound(id)
+	}
+	account = proto.Clone(account).(*banking.Account)
+	if err := update(account); err != nil {
+		return nil, err
+	}
+	account.UpdatedAt = timestamppb.Now()
+	if err := m.apply(&changeSet{accounts: []*banking.Account{account}}); err != nil {
+		return nil, err
+	}
+	return proto.Clone(account).(*banking.Account), nil
+}
+
+func (m *MemoryStore) SetAccountStatus(ctx context.Context, id string, status banking.AccountStatus, reason string, sweep *banking.Transaction) (*banking.Account, error) {
+	defer m.lock(ctx, "SetAccountStatus")()
+
+	// Freezes and pending holds would block the sweep, so report them first.
+	if account, ok := m.accounts[id]; ok && status == banking.AccountStatus_ACCOUNT_STATUS_CLOSED {
+		if err := checkCanClose(account); err != nil {
+			return nil, err
+		}
+		if moneyNanos(account.HeldAmount).Sign() != 0 {
+			return nil, fmt.Errorf("%w: account %s has %s in pending holds", AccountNotEmptyError, id, formatMoney(account.HeldAmount))
+		}
+	}
+	b := newTransferBatch(m)
+	if sweep != nil {
+		if err := b.add(sweep); err != nil {
+			return nil, err
+		}
+	}
+	account, ok := b.account(id)
+	if !ok {
+		return nil, accountNotFound(id)
+	}
+	if account.Status == banking.AccountStatus_ACCOUNT_STATUS_CLOSED {
+		return nil, &ResourceError{Err: AccountClosedError, ResourceType: accountResourceType, Name: id}
+	}
+	if status == banking.AccountStatus_ACCOUNT_STATUS_CLOSED && moneyNanos(account.Balance).Sign() != 0 {
+		return nil, fmt.Errorf("%w: account %s holds %s", AccountNotEmptyError, id, formatMoney(account.Balance))
+	}
+
+	account = proto.Clone(account).(*banking.Account)
+	account.Status = status
+	account.StatusReason = reason
+	b.stage(account)
+	if err := b.commit(); err != nil {
+		return nil, err
+	}
+	return proto.Clone(account).(*banking.Account), nil
+}
+
+// transferBatch stages transfers on top of the committed state, so that
+// each one sees the balances left by those before it.
+type transferBatch struct {
+	store    *MemoryStore
+	now      *timestamppb.Timestamp
+	accounts map[string]*banking.Account
+	order    []string
+	seen     map[string]bool
+	// originals holds the new state of transactions reversed in the batch.
+	originals map[string]*banking.Transaction
+
+	transactions []*banking.Transaction
+	// changed holds the accounts as left by each staged transaction.
+	changed [][]*banking.Account
+}
+
+func newTransferBatch(m *MemoryStore) *transferBatch {
+	return &transferBatch{
+		store:     m,
+		now:       timestamppb.Now(),
+		accounts:  make(map[string]*banking.Account),
+		seen:      make(map[string]bool),
+		originals: make(map[string]*banking.Transaction),
+	}
+}
+
+// commit applies everything staged in one change set.
+func (b *transferBatch) commit() error {
+	cs := &changeSet{transactions: b.transactions}
+	for _, id := range b.order {
+		cs.accounts = append(cs.accounts, b.accounts[id])
+	}
+	for _, original := range b.originals {
+		cs.transactions = append(cs.transactions, original)
+	}
+	if err := b.store.apply(cs); err != nil {
+		return err
+	}
+	for n, tx := range b.transactions {
+		for _, fn := range b.store.onCommit {
+			fn(tx, b.changed[n])
+		}
+	}
+	return nil
+}
+
+func (b *transferBatch) account(id string) (*banking.Account, bool) {
+	if account, ok := b.accounts[id]; ok {
+		return account, true
+	}
+	account, ok := b.store.accounts[id]
+	return account, ok
+}
+
+// stage records the new state of account, which the batch must own.
+func (b *transferBatch) stage(account *banking.Account) {
+	account.UpdatedAt = b.now
+	if _, ok := b.accounts[account.Id]; !ok {
+		b.order = append(b.order, account.Id)
+	}
+	b.accounts[account.Id] = account
+}
+
+// add checks tx against the staged state and stages it if it is allowed.
+func (b *transferBatch) add(tx *banking.Transaction) error {
+	if _, ok := b.store.transactions[tx.TransactionId]; ok || b.seen[tx.TransactionId] {
+		return &ResourceError{Err: TransactionExistsError, ResourceType: transactionResourceType, Name: tx.TransactionId}
+	}
+	return b.post(tx)
+}
+
+// parties returns the staged sender and receiver of tx and the amount
+// credited, checking that tx may move funds between them.
+func (b *transferBatch) parties(tx *banking.Transaction) (from, to *banking.Account, credit *banking.Money, err error) {
+	from, ok := b.account(tx.FromAccountId)
+	if !ok {
+		return nil, nil, nil, accountNotFound(tx.FromAccountId)
+	}
+	to, ok = b.account(tx.ToAccountId)
+	if !ok {
+		return nil, nil, nil, accountNotFound(tx.ToAccountId)
+	}
+	if err := checkCanSend(from); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := checkCanReceive(to); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if moneyNanos(tx.Amount).Sign() <= 0 {
+		return nil, nil, nil, fmt.Errorf("%w: transfer amount must be positive", InvalidMoneyError)
+	}
+	credit = tx.Amount
+	if tx.CreditAmount != nil {
+		credit = tx.CreditAmount
+	}
+	if tx.Amount.GetCurrencyCode() != from.Balance.GetCurrencyCode() || credit.GetCurrencyCode() != to.Balance.GetCurrencyCode() {
+		return nil, nil, nil, CurrencyMismatchError
+	}
+	return from, to, credit, nil
+}
+
+// post moves the funds for tx and stages it as posted, without checking
+// whether its ID is taken.
+func (b *transferBatch) post(tx *banking.Transaction) error {
+	from, to, credit, err := b.parties(tx)
+	if err != nil {
+		return err
+	}
+
+	var original *banking.Transaction
+	if tx.ReversalOf != "" {
+		if original, err = b.reverse(tx, credit); err != nil {
+			return err
+		}
+	}
+
+	if err := checkFunds(from, moneyNanos(tx.Amount)); err != nil {
+		return err
+	}
+
+	var changed []*banking.Account
+	if from != to {
+		fromBalance, err := subMoney(from.Balance, tx.Amount)
+		if err != nil {
+			return err
+		}
+		toBalance, err := addMoney(to.Balance, credit)
+		if err != nil {
+			return err
+		}
+		from = proto.Clone(from).(*banking.Account)
+		to = proto.Clone(to).(*banking.Account)
+		from.Balance = fromBalance
+		to.Balance = toBalance
+		b.stage(from)
+		b.stage(to)
+		changed = []*banking.Account{from, to}
+	}
+	if original != nil {
+		b.originals[original.TransactionId] = original
+	}
+	b.seen[tx.TransactionId] = true
+	posted := proto.Clone(tx).(*banking.Transaction)
+	posted.PostedAt = b.now
+	b.transactions = append(b.transactions, posted)
+	b.changed = append(b.changed, changed)
+	return nil
+}
+
+// reverse checks that reversal, which refunds refund to the original
+// sender, may be applied to the transaction it reverses, and returns the
+// new state of that transaction.
+func (b *transferBatch) reverse(reversal *banking.Transaction, refund *banking.Money) (*banking.Transaction, error) {
+	original, ok := b.originals[reversal.ReversalOf]
+	if !ok {
+		if original, ok = b.store.transactions[reversal.ReversalOf]; !ok {
+			return nil, transactionNotFound(reversal.ReversalOf)
+		}
+	}
+	if original.ReversalOf != "" {
+		return nil, fmt.Errorf("%w: %s is itself a reversal", TransactionNotReversibleError, original.TransactionId)
+	}
+	switch original.Status {
+	case banking.TransactionStatus_TRANSACTION_STATUS_PENDING, banking.TransactionStatus_TRANSACTION_STATUS_FAILED,
+		banking.TransactionStatus_TRANSACTION_STATUS_VOIDED, banking.TransactionStatus_TRANSACTION_STATUS_EXPIRED:
+		return nil, fmt.Errorf("%w: %s was never posted", TransactionNotReversibleError, original.TransactionId)
+	}
+	if reversal.FromAccountId != original.ToAccountId || reversal.ToAccountId != original.FromAccountId {
+		return nil, fmt.Errorf("%w: a reversal must move funds back to %s", TransactionNotReversibleError, original.FromAccountId)
+	}
+
+	reversed := refund
+	if original.ReversedAmount != nil {
+		var err error
+		if reversed, err = addMoney(original.ReversedAmount, refund); err != nil {
+			return nil, err
+		}
+	}
+	switch moneyNanos(reversed).Cmp(moneyNanos(original.Amount)) {
+	case 1:
+		return nil, fmt.Errorf("%w: %s in total would be refunded of %s", ReversalExceedsOriginalError,
+			formatMoney(reversed), formatMoney(original.Amount))
+	case 0:
+		original = proto.Clone(original).(*banking.Transaction)
+		original.Status = banking.TransactionStatus_TRANSACTION_STATUS_REVERSED
+	default:
+		original = proto.Clone(original).(*banking.Transaction)
+		original.Status = banking.TransactionStatus_TRANSACTION_STATUS_PARTIALLY_REVERSED
+	}
+	original.ReversedAmount = reversed
+	return original, nil
+}
+
+func (m *MemoryStore) ScanJournalEntries(ctx context.Context, order Ordering[*banking.JournalEntry], after *Cursor, fn func(entry *banking.JournalEntry) bool) error {
+	defer m.lock(ctx, "ScanJournalEntries")()
+
+	scanRecords(m.journalEntries, m.journalEntryIndexes, order, after, fn)
+	return nil
+}
+
+func (m *MemoryStore) AuthorizeHold(ctx context.Context, tx *banking.Transaction) error {
+	defer m.lock(ctx, "AuthorizeHold")()
+
+	b := newTransferBatch(m)
+	if err := b.hold(tx); err != nil {
+		return err
+	}
+	return b.commit()
+}
+
+func (m *MemoryStore) CaptureHold(ctx context.Context, id string, amount *banking.Money) (*banking.Transaction, error) {
+	defer m.lock(ctx, "CaptureHold")()
+
+	b := newTransferBatch(m)
+	tx, err := b.pending(id)
+	if err != nil {
+		return nil, err
+	}
+	if tx.ExpiresAt != nil && !b.now.AsTime().Before(tx.ExpiresAt.AsTime()) {
+		// Settle it now rather than leave the funds held until the next sweep.
+		if err := b.lapse(tx, banking.TransactionStatus_TRANSACTION_STATUS_EXPIRED, ""); err != nil {
+			return nil, err
+		}
+		if err := b.commit(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %s expired at %s", HoldExpiredError, id, tx.ExpiresAt.AsTime().Format(time.RFC3339))
+	}
+
+	captured := proto.Clone(tx).(*banking.Transaction)
+	captured.Status = banking.TransactionStatus_TRANSACTION_STATUS_POSTED
+	captured.ExpiresAt = nil
+	if amount != nil {
+		if amount.CurrencyCode != tx.Amount.CurrencyCode {
+			return nil, fmt.Errorf("%w: %s was authorised in %s", CurrencyMismatchError, id, tx.Amount.CurrencyCode)
+		}
+		if moneyNanos(amount).Cmp(moneyNanos(tx.Amount)) > 0 {
+			return nil, fmt.Errorf("%w: %s was authorised for %s", CaptureExceedsAuthorizationError, id, formatMoney(tx.Amount))
+		}
+		if tx.CreditAmount != nil {
+			// Credit the same share of the converted amount, at the rate the
+			// hold was converted at.
+			rate := new(big.Rat).SetFrac(moneyNanos(tx.CreditAmount), moneyNanos(tx.Amount))
+			if captured.CreditAmount, err = convertMoney(amount, tx.CreditAmount.CurrencyCode, rate); err != nil {
+				return nil, err
+			}
+		}
+		captured.Amount = amount
+	}
+
+	if _, err := b.release(tx); err != nil {
+		return nil, err
+	}
+	if err := b.post(captured); err != nil {
+		return nil, err
+	}
+	if err := b.commit(); err != nil {
+		return nil, err
+	}
+	return proto.Clone(b.transactions[0]).(*banking.Transaction), nil
+}
+
+func (m *MemoryStore) VoidHold(ctx context.Context, id string, reason string) (*banking.Transaction, error) {
+	defer m.lock(ctx, "VoidHold")()
+
+	b := newTransferBatch(m)
+	tx, err := b.pending(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.lapse(tx, banking.TransactionStatus_TRANSACTION_STATUS_VOIDED, reason); err != nil {
+		return nil, err
+	}
+	if err := b.commit(); err != nil {
+		return nil, err
+	}
+	return proto.Clone(b.transactions[0]).(*banking.Transaction), nil
+}
+
+func (m *MemoryStore) ExpireHolds(ctx context.Context, now time.Time) ([]string, error) {
+	defer m.lock(ctx, "ExpireHolds")()
+
+	var ids []string
+	scanRecords(m.transactions, m.transactionIndexes, holdsByExpiry, nil, func(tx *banking.Transaction) bool {
+		if now.Before(tx.ExpiresAt.AsTime()) {
+			return false
+		}
+		ids = append(ids, tx.TransactionId)
+		return true
+	})
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	b := newTransferBatch(m)
+	for _, id := range ids {
+		if err := b.lapse(m.transactions[id], banking.TransactionStatus_TRANSACTION_STATUS_EXPIRED, ""); err != nil {
+			return nil, err
+		}
+	}
+	if err := b.commit(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// hold checks tx as add would and stages it as a pending authorisation,
+// holding its amount on the sender.
+func (b *transferBatch) hold(tx *banking.Transaction) error {
+	if _, ok := b.store.transactions[tx.TransactionId]; ok || b.seen[tx.TransactionId] {
+		return &ResourceError{Err: TransactionExistsError, ResourceType: transactionResourceType, Name: tx.TransactionId}
+	}
+	from, _, _, err := b.parties(tx)
+	if err != nil {
+		return err
+	}
+	if err := checkFunds(from, moneyNanos(tx.Amount)); err != nil {
+		return err
+	}
+
+	held := tx.Amount
+	if from.HeldAmount != nil {
+		if held, err = addMoney(from.HeldAmount, tx.Amount); err != nil {
+			return err
+		}
+	}
+	from = proto.Clone(from).(*banking.Account)
+	from.HeldAmount = held
+	b.stage(from)
+	b.seen[tx.TransactionId] = true
+	b.transactions = append(b.transactions, proto.Clone(tx).(*banking.Transaction))
+	b.changed = append(b.changed, []*banking.Account{from})
+	return nil
+}
+
+// pending returns the committed pending authorisation with the given ID.
+func (b *transferBatch) pending(id string) (*banking.Transaction, error) {
+	tx, ok := b.store.transactions[id]
+	if !ok {
+		return nil, transactionNotFound(id)
+	}
+	if tx.Status != banking.TransactionStatus_TRANSACTION_STATUS_PENDING {
+		return nil, fmt.Errorf("%w: %s is %s", HoldNotPendingError, id, transactionStatus(tx))
+	}
+	return tx, nil
+}
+
+// release stages the sender of pending tx with its hold removed.
+func (b *transferBatch) release(tx *banking.Transaction) (*banking.Account, error) {
+	from, ok := b.account(tx.FromAccountId)
+	if !ok {
+		return nil, accountNotFound(tx.FromAccountId)
+	}
+	held, err := subMoney(from.HeldAmount, tx.Amount)
+	if err != nil {
+		return nil, err
+	}
+	from = proto.Clone(from).(*banking.Account)
+	from.HeldAmount = held
+	b.stage(from)
+	return from, nil
+}
+
+// lapse releases the hold of pending tx and stages it with status, which
+// must be one in which no funds have moved.
+func (b *transferBatch) lapse(tx *banking.Transaction, status banking.TransactionStatus, reason string) error {
+	from, err := b.release(tx)
+	if err != nil {
+		return err
+	}
+	tx = proto.Clone(tx).(*banking.Transaction)
+	tx.Status = status
+	tx.Reason = reason
+	b.seen[tx.TransactionId] = true
+	b.transactions = append(b.transactions, tx)
+	b.changed = append(b.changed, []*banking.Account{from})
+	return nil
+}
+
+func (m *MemoryStore) PostJournalEntry(ctx context.Context, entry *banking.JournalEntry) error {
+	defer m.lock(ctx, "PostJournalEntry")()
+
+	if _, ok := m.journalEntries[entry.EntryId]; ok {
+		return &ResourceError{Err: JournalEntryExistsError, ResourceType: journalEntryResourceType, Name: entry.EntryId}
+	}
+
+	now := timestamppb.Now()
+	staged := make(map[string]*banking.Account)
+	var order []string
+	totals := make(map[string]*big.Int)
+	for _, leg := range entry.Legs {
+		account, ok := staged[leg.AccountId]
+		if !ok {
+			if account, ok = m.accounts[leg.AccountId]; !ok {
+				return accountNotFound(leg.AccountId)
+			}
+			account = proto.Clone(account).(*banking.Account)
+			account.UpdatedAt = now
+			staged[account.Id] = account
+			order = append(order, account.Id)
+		}
+		if leg.Amount.GetCurrencyCode() != account.Balance.GetCurrencyCode() {
+			return fmt.Errorf("%w: account %s holds %s", CurrencyMismatchError, account.Id, account.Balance.CurrencyCode)
+		}
+		check := checkCanReceive
+		if moneyNanos(leg.Amount).Sign() < 0 {
+			check = checkCanSend
+		}
+		if err := check(account); err != nil {
+			return err
+		}
+		balance, err := addMoney(account.Balance, leg.Amount)
+		if err != nil {
+			return err
+		}
+		account.Balance = balance
+		if totals[leg.Amount.CurrencyCode] == nil {
+			totals[leg.Amount.CurrencyCode] = new(big.Int)
+		}
+		totals[leg.Amount.CurrencyCode].Add(totals[leg.Amount.CurrencyCode], moneyNanos(leg.Amount))
+	}
+	for _, total := range totals {
+		if total.Sign() != 0 {
+			return fmt.Errorf("%w: journal entry legs must sum to zero in each currency", InvalidMoneyError)
+		}
+	}
+
+	cs := &changeSet{journalEntries: []*banking.JournalEntry{proto.Clone(entry).(*banking.JournalEntry)}}
+	for _, id := range order {
+		before, after := m.accounts[id], staged[id]
+		debit := new(big.Int).Sub(moneyNanos(before.Balance), moneyNanos(after.Balance))
+		if debit.Sign() > 0 {
+			if err := checkFunds(before, debit); err != nil {
+				return err
+			}
+		}
+		cs.accounts = append(cs.accounts, after)
+	}
+	if err := m.apply(cs); err != nil {
+		return err
+	}
+	for _, fn := range m.onCommit {
+		fn(cs.journalEntries[0], cs.accounts)
+	}
+	return nil
+}
+
+func (m *MemoryStore) CreateSchedule(ctx context.Context, schedule *banking.Schedule) error {
+	defer m.lock(ctx, "CreateSchedule")()
+
+	if _, ok := m.schedules[schedule.ScheduleId]; ok {
+		return &ResourceError{Err: ScheduleExistsError, ResourceType: scheduleResourceType, Name: schedule.ScheduleId}
+	}
+	return m.apply(&changeSet{
+		schedules: []*banking.Schedule{proto.Clone(schedule).(*banking.Schedule)},
+	})
+}
+
+func (m *MemoryStore) GetSchedule(ctx context.Context, id string) (*banking.Schedule, error) {
+	defer m.lock(ctx, "GetSchedule")()
+
+	schedule, ok := m.schedules[id]
+	if !ok {
+		return nil, scheduleNotFound(id)
+	}
+	return proto.Clone(schedule).(*banking.Schedule), nil
+}
+
+func (m *MemoryStore) ScanSchedules(ctx context.Context, order Ordering[*banking.Schedule], after *Cursor, fn func(schedule *banking.Schedule) bool) error {
+	defer m.lock(ctx, "ScanSchedules")()
+
+	scanRecords(m.schedules, m.scheduleIndexes, order, after, fn)
+	return nil
+}
+
+func (m *MemoryStore) UpdateSchedule(ctx context.Context, id string, update func(schedule *banking.Schedule) error) (*banking.Schedule, error) {
+	defer m.lock(ctx, "UpdateSchedule")()
+
+	schedule, ok := m.schedules[id]
+	if !ok {
+		return nil, scheduleNotFound(id)
+	}
+	schedule = proto.Clone(schedule).(*banking.Schedule)
+	if err := update(schedule); err != nil {
+		return nil, err
+	}
+	schedule.UpdatedAt = timestamppb.Now()
+	if err := m.apply(&changeSet{schedules: []*banking.Schedule{schedule}}); err != nil {
+		return nil, err
+	}
+	return proto.Clone(schedule).(*banking.Schedule), nil
+}
+
+func (m *MemoryStore) PutIdempotencyRecord(ctx context.Context, record *IdempotencyRecord) error {
+	defer m.lock(ctx, "PutIdempotencyRecord")()
+
+	// Expired records are dropped without being committed; they are
+	// ignored wherever they are still found.
+	now := time.Now()
+	if now.After(m.nextIdempotencySweep) {
+		for key, r := range m.idempotency {
+			if now.After(r.Expires) {
+				delete(m.idempotency, key)
+			}
+		}
+		m.nextIdempotencySweep = now.Add(time.Minute)
+	}
+	r := *record
+	return m.apply(&changeSet{idempotency: []*IdempotencyRecord{&r}})
+}
+
+func (m *MemoryStore) GetIdempotencyRecord(ctx context.Context, key string) (*IdempotencyRecord, error) {
+	defer m.lock(ctx, "GetIdempotencyRecord")()
+
+	record, ok := m.idempotency[key]
+	if !ok || time.Now().After(record.Expires) {
+		return nil, nil
+	}
+	r := *record
+	return &r, nil
+}
+
+func (m *MemoryStore) OnCommit(fn func(cause proto.Message, accounts []*banking.Account)) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.onCommit = append(m.onCommit, fn)
+}
+
+func (m *MemoryStore) OnAccountStatus(fn func(before, after *banking.Account)) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.onStatus = append(m.onStatus, fn)
+}
+
+// recordIndex holds the cursor of every record of one type in the order
+// of an Ordering, so that scans in that order can seek rather than sort.
+type recordIndex[T any] struct {
+	order   Ordering[T]
+	cursors []Cursor
+}
+
+// recordIndexes are the indexes kept for one type of record, by ordering
+// name.
+type recordIndexes[T any] map[string]*recordIndex[T]
+
+func newRecordIndexes[T any](orders ...Ordering[T]) recordIndexes[T] {
+	indexes := make(recordIndexes[T], len(orders))
+	for _, order := range orders {
+		indexes[order.Name] = &recordIndex[T]{order: order}
+	}
+	return indexes
+}
+
+// search returns the position of the first cursor not before c.
+func (x *recordIndex[T]) search(c Cursor) int {
+	return sort.Search(len(x.cursors), func(i int) bool { return x.cursors[i].compare(c) >= 0 })
+}
+
+// update moves a record from its cursor before a change, if it existed,
+// to its cursor after it, adding or dropping it as it enters or leaves the
+// ordering of each index.
+func (ix recordIndexes[T]) update(before, after T, existed bool) {
+	for _, x := range ix {
+		c, in := x.order.cursor(after), x.order.holds(after)
+		if existed && x.order.holds(before) {
+			old := x.order.cursor(before)
+			if in && old.compare(c) == 0 {
+				continue
+			}
+			i := x.search(old)
+			x.cursors = slices.Delete(x.cursors, i, i+1)
+		}
+		if !in {
+			continue
+		}
+		// New records usually sort last, as most orderings start with the
+		// creation time.
+		if n := len(x.cursors); n == 0 || x.cursors[n-1].compare(c) < 0 {
+			x.cursors = append(x.cursors, c)
+		} else {
+			x.cursors = slices.Insert(x.cursors, x.search(c), c)
+		}
+	}
+}
+
+// rebuild replaces every index with one built from records.
+func (ix recordIndexes[T]) rebuild(records map[string]T) {
+	for _, x := range ix {
+		x.cursors = sortedCursors(records, x.order)
+	}
+}
+
+func sortedCursors[T any](records map[string]T, order Ordering[T]) []Cursor {
+	cursors := make([]Cursor, 0, len(records))
+	for _, record := range records {
+		if order.holds(record) {
+			cursors = append(cursors, order.cursor(record))
+		}
+	}
+	slices.SortFunc(cursors, Cursor.compare)
+	return cursors
+}
+
+// scanRecords calls fn with records in order, starting strictly after the
+// cursor after if it is not nil, until fn returns false. It seeks in the
+// index for order if there is one, and sorts every record otherwise.
+func scanRecords[T any](records map[string]T, indexes recordIndexes[T], order Ordering[T], after *Cursor, fn func(T) bool) {
+	var cursors []Cursor
+	if x, ok := indexes[order.Name]; ok {
+		cursors = x.cursors
+	} else {
+		cursors = sortedCursors(records, order)
+	}
+	if order.Desc {
+		i := len(cursors)
+		if after != nil {
+			i = sort.Search(len(cursors), func(i int) bool { return cursors[i].compare(*after) >= 0 })
+		}
+		for i--; i >= 0; i-- {
+			if !fn(records[cursors[i].ID]) {
+				return
+			}
+		}
+		return
+	}
+	i := 0
+	if after != nil {
+		i = sort.Search(len(cursors), func(i int) bool { return cursors[i].compare(*after) > 0 })
+	}
+	for ; i < len(cursors); i++ {
+		if !fn(records[cursors[i].ID]) {
+			return
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMemoryStore_Transfer(t *testing.T) {
+	m := NewMemoryStore()
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "a", Balance: usd(100)}))
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "b", Balance: usd(100)}))
+
+	err := m.Transfer(context.Background(), &banking.Transaction{TransactionId: "t1", FromAccountId: "a", ToAccountId: "b", Amount: usd(30)})
+	assert.NoError(t, err)
+
+	a, _ := m.GetAccount(context.Background(), "a")
+	b, _ := m.GetAccount(context.Background(), "b")
+	assert.Equal(t, int64(70), a.Balance.Units)
+	assert.Equal(t, int64(130), b.Balance.Units)
+
+	tx, err := m.GetTransaction(context.Background(), "t1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(30), tx.Amount.Units)
+}
+
+func TestMemoryStore_TransferUnknownAccount(t *testing.T) {
+	m := NewMemoryStore()
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "a", Balance: usd(100)}))
+
+	err := m.Transfer(context.Background(), &banking.Transaction{TransactionId: "t1", FromAccountId: "a", ToAccountId: "missing", Amount: usd(30)})
+	assert.ErrorIs(t, err, AccountNotFoundError)
+
+	a, _ := m.GetAccount(context.Background(), "a")
+	assert.Equal(t, int64(100), a.Balance.Units)
+	_, err = m.GetTransaction(context.Background(), "t1")
+	assert.ErrorIs(t, err, TransactionNotFoundError)
+}
+
+func TestMemoryStore_ReturnsCopies(t *testing.T) {
+	m := NewMemoryStore()
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "a", Balance: usd(100)}))
+
+	a, _ := m.GetAccount(context.Background(), "a")
+	a.Balance.Units = 0
+
+	a, _ = m.GetAccount(context.Background(), "a")
+	assert.Equal(t, int64(100), a.Balance.Units)
+}
+
+func TestMemoryStore_TransferOverdraftPolicies(t *testing.T) {
+	m := NewMemoryStore()
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "none", Balance: usd(10)}))
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{
+		Id:              "unlimited",
+		Balance:         usd(10),
+		OverdraftPolicy: banking.OverdraftPolicy_OVERDRAFT_POLICY_UNLIMITED,
+	}))
+
+	err := m.Transfer(context.Background(), &banking.Transaction{TransactionId: "t1", FromAccountId: "none", ToAccountId: "unlimited", Amount: usd(11)})
+	var insufficient *InsufficientFundsError
+	assert.ErrorAs(t, err, &insufficient)
+	assert.Equal(t, "none", insufficient.AccountID)
+	assertProtoEqual(t, usd(1), insufficient.Shortfall)
+
+	err = m.Transfer(context.Background(), &banking.Transaction{TransactionId: "t2", FromAccountId: "unlimited", ToAccountId: "none", Amount: usd(1000)})
+	assert.NoError(t, err)
+	u, _ := m.GetAccount(context.Background(), "unlimited")
+	assert.Equal(t, int64(-990), u.Balance.Units)
+}
+
+func TestMemoryStore_CreateAccountDuplicate(t *testing.T) {
+	m := NewMemoryStore()
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "a", Balance: usd(100)}))
+
+	err := m.CreateAccount(context.Background(), &banking.Account{Id: "a", Balance: usd(5)})
+	assert.ErrorIs(t, err, AccountExistsError)
+	a, _ := m.GetAccount(context.Background(), "a")
+	assert.Equal(t, int64(100), a.Balance.Units)
+}
+
+func TestMemoryStore_TransferDuplicateID(t *testing.T) {
+	m := NewMemoryStore()
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "a", Balance: usd(100)}))
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "b", Balance: usd(100)}))
+	tx := &banking.Transaction{TransactionId: "t1", FromAccountId: "a", ToAccountId: "b", Amount: usd(10)}
+	assert.NoError(t, m.Transfer(context.Background(), tx))
+
+	err := m.Transfer(context.Background(), tx)
+	assert.ErrorIs(t, err, TransactionExistsError)
+	a, _ := m.GetAccount(context.Background(), "a")
+	assert.Equal(t, int64(90), a.Balance.Units)
+}
+
+func TestMemoryStore_TransferBatch(t *testing.T) {
+	m := NewMemoryStore()
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "a", Balance: usd(100)}))
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "b", Balance: usd(0)}))
+	commits := 0
+	m.commit = func(cs *changeSet) error {
+		commits++
+		return nil
+	}
+
+	errs := m.TransferBatch(context.Background(), []*banking.Transaction{
+		{TransactionId: "t1", FromAccountId: "a", ToAccountId: "b", Amount: usd(60)},
+		{TransactionId: "t2", FromAccountId: "a", ToAccountId: "b", Amount: usd(60)},
+		// Spends the credit from t1, which is not committed yet.
+		{TransactionId: "t3", FromAccountId: "b", ToAccountId: "a", Amount: usd(50)},
+		{TransactionId: "t1", FromAccountId: "b", ToAccountId: "a", Amount: usd(1)},
+	})
+	assert.NoError(t, errs[0])
+	var insufficient *InsufficientFundsError
+	assert.ErrorAs(t, errs[1], &insufficient)
+	assert.NoError(t, errs[2])
+	assert.ErrorIs(t, errs[3], TransactionExistsError)
+	assert.Equal(t, 1, commits)
+
+	a, _ := m.GetAccount(context.Background(), "a")
+	b, _ := m.GetAccount(context.Background(), "b")
+	assert.Equal(t, int64(90), a.Balance.Units)
+	assert.Equal(t, int64(10), b.Balance.Units)
+	_, err := m.GetTransaction(context.Background(), "t2")
+	assert.ErrorIs(t, err, TransactionNotFoundError)
+}
+
+func TestMemoryStore_PostJournalEntry(t *testing.T) {
+	m := NewMemoryStore()
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "a", Balance: usd(100)}))
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "b", Balance: usd(0)}))
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "c", Balance: usd(0)}))
+
+	err := m.PostJournalEntry(context.Background(), &banking.JournalEntry{EntryId: "e1", Legs: []*banking.JournalLeg{
+		{AccountId: "a", Amount: usd(-90)},
+		{AccountId: "b", Amount: usd(60)},
+		{AccountId: "c", Amount: usd(30)},
+	}})
+	assert.NoError(t, err)
+
+	// The second debit from a overdraws it, so no leg posts.
+	err = m.PostJournalEntry(context.Background(), &banking.JournalEntry{EntryId: "e2", Legs: []*banking.JournalLeg{
+		{AccountId: "a", Amount: usd(-5)},
+		{AccountId: "b", Amount: usd(15)},
+		{AccountId: "a", Amount: usd(-10)},
+	}})
+	var insufficient *InsufficientFundsError
+	assert.ErrorAs(t, err, &insufficient)
+	assert.Equal(t, int64(5), insufficient.Shortfall.Units)
+
+	err = m.PostJournalEntry(context.Background(), &banking.JournalEntry{EntryId: "e3", Legs: []*banking.JournalLeg{
+		{AccountId: "b", Amount: usd(-10)},
+		{AccountId: "c", Amount: usd(5)},
+	}})
+	assert.ErrorIs(t, err, InvalidMoneyError)
+
+	err = m.PostJournalEntry(context.Background(), &banking.JournalEntry{EntryId: "e1", Legs: []*banking.JournalLeg{
+		{AccountId: "b", Amount: usd(-10)},
+		{AccountId: "c", Amount: usd(10)},
+	}})
+	assert.ErrorIs(t, err, JournalEntryExistsError)
+
+	for id, want := range map[string]int64{"a": 10, "b": 60, "c": 30} {
+		account, _ := m.GetAccount(context.Background(), id)
+		assert.Equal(t, want, account.Balance.Units, id)
+	}
+}
+
+func TestMemoryStore_TransferReversal(t *testing.T) {
+	m := NewMemoryStore()
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "a", Balance: usd(100)}))
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "b", Balance: usd(100)}))
+	assert.NoError(t, m.Transfer(context.Background(), &banking.Transaction{TransactionId: "t1", FromAccountId: "a", ToAccountId: "b", Amount: usd(30)}))
+
+	// Two reversals in one batch may not refund more than the original.
+	errs := m.TransferBatch(context.Background(), []*banking.Transaction{
+		{TransactionId: "r1", FromAccountId: "b", ToAccountId: "a", Amount: usd(20), ReversalOf: "t1"},
+		{TransactionId: "r2", FromAccountId: "b", ToAccountId: "a", Amount: usd(20), ReversalOf: "t1"},
+		{TransactionId: "r3", FromAccountId: "a", ToAccountId: "b", Amount: usd(5), ReversalOf: "t1"},
+	})
+	assert.NoError(t, errs[0])
+	assert.ErrorIs(t, errs[1], ReversalExceedsOriginalError)
+	assert.ErrorIs(t, errs[2], TransactionNotReversibleError)
+
+	t1, _ := m.GetTransaction(context.Background(), "t1")
+	assert.Equal(t, banking.TransactionStatus_TRANSACTION_STATUS_PARTIALLY_REVERSED, t1.Status)
+	assert.Equal(t, int64(20), t1.ReversedAmount.Units)
+}
+
+func TestMemoryStore_PostJournalEntryAccountStatus(t *testing.T) {
+	m := NewMemoryStore()
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "a", Balance: usd(100), Status: banking.AccountStatus_ACCOUNT_STATUS_FROZEN}))
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "b", Balance: usd(100)}))
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "c", Balance: usd(0), Status: banking.AccountStatus_ACCOUNT_STATUS_CLOSED}))
+
+	// Frozen accounts may be credited but not debited.
+	assert.NoError(t, m.PostJournalEntry(context.Background(), &banking.JournalEntry{EntryId: "e1", Legs: []*banking.JournalLeg{
+		{AccountId: "b", Amount: usd(-10)},
+		{AccountId: "a", Amount: usd(10)},
+	}}))
+	err := m.PostJournalEntry(context.Background(), &banking.JournalEntry{EntryId: "e2", Legs: []*banking.JournalLeg{
+		{AccountId: "a", Amount: usd(-10)},
+		{AccountId: "b", Amount: usd(10)},
+	}})
+	assert.ErrorIs(t, err, AccountFrozenError)
+	err = m.PostJournalEntry(context.Background(), &banking.JournalEntry{EntryId: "e3", Legs: []*banking.JournalLeg{
+		{AccountId: "b", Amount: usd(-10)},
+		{AccountId: "c", Amount: usd(10)},
+	}})
+	assert.ErrorIs(t, err, AccountClosedError)
+}
+
+func TestMemoryStore_ExpireHolds(t *testing.T) {
+	m := NewMemoryStore()
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "a", Balance: usd(100)}))
+	assert.NoError(t, m.CreateAccount(context.Background(), &banking.Account{Id: "b", Balance: usd(0)}))
+
+	now := time.Now()
+	for id, ttl := range map[string]time.Duration{"h1": time.Minute, "h2": time.Hour, "h3": 2 * time.Minute} {
+		assert.NoError(t, m.AuthorizeHold(context.Background(), &banking.Transaction{
+			TransactionId: id,
+			FromAccountId: "a",
+			ToAccountId:   "b",
+			Amount:        usd(10),
+			Status:        banking.TransactionStatus_TRANSACTION_STATUS_PENDING,
+			ExpiresAt:     timestamppb.New(now.Add(ttl)),
+		}))
+	}
+	a, _ := m.GetAccount(context.Background(), "a")
+	assert.Equal(t, int64(30), a.HeldAmount.Units)
+
+	ids, err := m.ExpireHolds(context.Background(), now.Add(5*time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"h1", "h3"}, ids)
+
+	a, _ = m.GetAccount(context.Background(), "a")
+	assert.Equal(t, int64(10), a.HeldAmount.Units)
+	assert.Equal(t, int64(100), a.Balance.Units)
+	h1, _ := m.GetTransaction(context.Background(), "h1")
+	assert.Equal(t, banking.TransactionStatus_TRANSACTION_STATUS_EXPIRED, h1.Status)
+	h2, _ := m.GetTransaction(context.Background(), "h2")
+	assert.Equal(t, banking.TransactionStatus_TRANSACTION_STATUS_PENDING, h2.Status)
+
+	// Only holds still pending are left for the next sweep to visit.
+	expiring := func() []string {
+		var ids []string
+		for _, c := range m.transactionIndexes[holdsByExpiry.Name].cursors {
+			ids = append(ids, c.ID)
+		}
+		return ids
+	}
+	assert.Equal(t, []string{"h2"}, expiring())
+	_, err = m.VoidHold(context.Background(), "h2", "")
+	assert.NoError(t, err)
+	assert.Empty(t, expiring())
+	m.reindex()
+	assert.Empty(t, expiring())
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// metrics holds the server's Prometheus collectors. Each server registers
+// them with its own registry, so servers in one process do not collide.
+type metrics struct {
+	registry         *prometheus.Registry
+	requests         *prometheus.CounterVec
+	latency          *prometheus.HistogramVec
+	transactions     prometheus.Counter
+	volume           *prometheus.CounterVec
+	failedTransfers  *prometheus.CounterVec
+	accountsByStatus *prometheus.GaugeVec
+}
+
+func newMetrics() *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "banking_rpc_requests_total",
+			Help: "Calls handled, by method and status code.",
+		}, []string{"method", "code"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "banking_rpc_duration_seconds",
+			Help:    "Time taken to handle calls, by method.",
+			Buckets: []float64{.0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{"method"}),
+		transactions: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "banking_transactions_total",
+			Help: "Transactions posted, including reversals, captures, sweeps and scheduled transfers.",
+		}),
+		volume: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "banking_transfer_volume_total",
+			Help: "Sum of the amounts debited by posted transactions, by currency.",
+		}, []string{"currency"}),
+		failedTransfers: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "banking_failed_transfers_total",
+			Help: "Transfers that could not be posted, by error reason.",
+		}, []string{"reason"}),
+		accountsByStatus: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "banking_accounts",
+			Help: "Accounts in the store, by status.",
+		}, []string{"status"}),
+	}
+	m.registry.MustRegister(
+		m.requests, m.latency, m.transactions, m.volume, m.failedTransfers, m.accountsByStatus,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// countAccounts sets the account gauge to the accounts in store. It is
+// called once the store is open, so that the gauge covers accounts
+// recovered on startup, and accountStatusChanged keeps it up to date.
+func (m *metrics) countAccounts(ctx context.Context, store Store) error {
+	accounts, err := store.ListAccounts(ctx)
+	if err != nil {
+		return err
+	}
+	m.accountsByStatus.Reset()
+	for _, account := range accounts {
+		m.accountsByStatus.WithLabelValues(accountStatusLabel(account.Status)).Inc()
+	}
+	return nil
+}
+
+// accountStatusChanged is the store's OnAccountStatus hook.
+func (m *metrics) accountStatusChanged(before, after *banking.Account) {
+	if before != nil {
+		m.accountsByStatus.WithLabelValues(accountStatusLabel(before.Status)).Dec()
+	}
+	m.accountsByStatus.WithLabelValues(accountStatusLabel(after.Status)).Inc()
+}
+
+// accountStatusLabel turns ACCOUNT_STATUS_ACTIVE into active.
+func accountStatusLabel(status banking.AccountStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "ACCOUNT_STATUS_"))
+}
+
+func (m *metrics) observeCall(method string, start time.Time, err error) {
+	m.requests.WithLabelValues(method, status.Code(err).String()).Inc()
+	m.latency.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+func (m *metrics) transactionPosted(tx *banking.Transaction) {
+	m.transactions.Inc()
+	amount := float64(tx.Amount.GetUnits()) + float64(tx.Amount.GetNanos())/1e9
+	m.volume.WithLabelValues(tx.Amount.GetCurrencyCode()).Add(amount)
+}
+
+func (m *metrics) transferFailed(err error) {
+	m.failedTransfers.WithLabelValues(errorReason(err)).Inc()
+}
+
+// errorReason returns the reason err is reported to clients with.
+func errorReason(err error) string {
+	for _, detail := range status.Convert(statusError(err)).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+	return ReasonInternal
+}
+
+// MetricsHandler serves the server's metrics in the Prometheus exposition
+// format.
+func (s *Server) MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(s.metrics.registry, promhttp.HandlerOpts{})
+}
+
+// serveMetrics serves MetricsHandler at /metrics on MetricsAddress until
+// the server is stopped.
+func (s *Server) serveMetrics() error {
+	listener, err := net.Listen("tcp", s.MetricsAddress)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", s.MetricsHandler())
+	s.metricsServer = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func(srv *http.Server) {
+		if err := srv.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+			s.Logger.Error("Failed to serve metrics", "error", err)
+		}
+	}(s.metricsServer)
+	return nil
+}
+
+// metricsUnaryInterceptor counts every call and how long it took.
+func (s *Server) metricsUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	res, err := handler(ctx, req)
+	s.metrics.observeCall(info.FullMethod, start, err)
+	return res, err
+}
+
+func (s *Server) metricsStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	s.metrics.observeCall(info.FullMethod, start, err)
+	return err
+}
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_Metrics(t *testing.T) {
+	s := getNewTestServer()
+	addr := startTestServer(t, s)
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := banking.NewBankingServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	ids := make([]string, 2)
+	for i := range ids {
+		res, err := client.CreateAccount(ctx, &banking.AccountRequest{InitialBalance: usd(100)})
+		require.NoError(t, err)
+		ids[i] = res.AccountId
+	}
+	for _, amount := range []*banking.Money{usd(10), {CurrencyCode: "USD", Units: 2, Nanos: 500000000}} {
+		_, err = client.MakeTransaction(ctx, &banking.TransactionRequest{FromAccountId: ids[0], ToAccountId: ids[1], Amount: amount})
+		require.NoError(t, err)
+	}
+	_, err = client.MakeTransaction(ctx, &banking.TransactionRequest{FromAccountId: ids[0], ToAccountId: ids[1], Amount: usd(1000)})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	m := s.metrics
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.requests.WithLabelValues("/banking.BankingService/CreateAccount", "OK")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.requests.WithLabelValues("/banking.BankingService/MakeTransaction", "OK")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.requests.WithLabelValues("/banking.BankingService/MakeTransaction", "FailedPrecondition")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.transactions))
+	assert.Equal(t, 12.5, testutil.ToFloat64(m.volume.WithLabelValues("USD")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.failedTransfers.WithLabelValues(ReasonInsufficientFunds)))
+
+	res := httptest.NewRecorder()
+	s.MetricsHandler().ServeHTTP(res, httptest.NewRequest("GET", "/metrics", nil))
+	body := res.Body.String()
+	assert.Contains(t, body, `banking_accounts{status="active"} 2`)
+	assert.Contains(t, body, `banking_rpc_duration_seconds_count{method="/banking.BankingService/MakeTransaction"} 3`)
+	assert.Contains(t, body, "go_goroutines")
+
+	_, err = client.FreezeAccount(ctx, &banking.AccountStatusRequest{AccountId: ids[0]})
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.accountsByStatus.WithLabelValues("active")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.accountsByStatus.WithLabelValues("frozen")))
+}
+
+func TestServer_Metrics_CountsRecoveredAccounts(t *testing.T) {
+	store := NewMemoryStore()
+	for _, id := range []string{"a", "b"} {
+		require.NoError(t, store.CreateAccount(context.Background(), &banking.Account{
+			Id: id, Balance: usd(0), Status: banking.AccountStatus_ACCOUNT_STATUS_ACTIVE,
+		}))
+	}
+	s := NewServer(store)
+	s.TestMode(false)
+	startTestServer(t, s)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(s.metrics.accountsByStatus.WithLabelValues("active")))
+}
+
+func TestErrorReason(t *testing.T) {
+	assert.Equal(t, ReasonAccountFrozen, errorReason(AccountFrozenError))
+	assert.Equal(t, ReasonAccountNotFound, errorReason(accountNotFound("a")))
+	assert.Equal(t, ReasonInsufficientFunds, errorReason(&InsufficientFundsError{AccountID: "a", Shortfall: usd(1)}))
+	assert.Equal(t, ReasonInternal, errorReason(context.Canceled))
+}
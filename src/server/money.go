@@ -0,0 +1,143 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+)
+
+const nanosPerUnit = 1_000_000_000
+
+var InvalidMoneyError = errors.New("Invalid money amount")
+var CurrencyMismatchError = errors.New("Currency mismatch")
+var MoneyOverflowError = errors.New("Money amount out of range")
+var CrossCurrencyDisabledError = errors.New("Cross-currency transfers are not enabled")
+var ExchangeRateUnavailableError = errors.New("Exchange rate unavailable")
+
+var currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+var (
+	bigNanosPerUnit = big.NewInt(nanosPerUnit)
+	bigMaxNanos     = new(big.Int).Add(new(big.Int).Mul(big.NewInt(math.MaxInt64), bigNanosPerUnit), big.NewInt(nanosPerUnit-1))
+	bigMinNanos     = new(big.Int).Neg(bigMaxNanos)
+)
+
+// RateSource supplies exchange rates for cross-currency transfers.
+type RateSource interface {
+	// Rate returns how many units of currency to are bought by one unit of
+	// currency from.
+	Rate(from, to string) (*big.Rat, error)
+}
+
+// StaticRates is a RateSource backed by a fixed table keyed by "FROM/TO".
+type StaticRates map[string]*big.Rat
+
+func (r StaticRates) Rate(from, to string) (*big.Rat, error) {
+	rate, ok := r[from+"/"+to]
+	if !ok {
+		return nil, fmt.Errorf("%w: no rate from %s to %s", ExchangeRateUnavailableError, from, to)
+	}
+	return rate, nil
+}
+
+// validateMoney checks that m is a well-formed amount: a three letter
+// currency code and nanos in range with the same sign as units.
+func validateMoney(m *banking.Money) error {
+	switch {
+	case m == nil:
+		return fmt.Errorf("%w: missing", InvalidMoneyError)
+	case !currencyCodePattern.MatchString(m.CurrencyCode):
+		return fmt.Errorf("%w: bad currency code %q", InvalidMoneyError, m.CurrencyCode)
+	case m.Nanos <= -nanosPerUnit || m.Nanos >= nanosPerUnit:
+		return fmt.Errorf("%w: nanos out of range", InvalidMoneyError)
+	case m.Units > 0 && m.Nanos < 0, m.Units < 0 && m.Nanos > 0:
+		return fmt.Errorf("%w: units and nanos have different signs", InvalidMoneyError)
+	}
+	return nil
+}
+
+// moneyNanos returns m as a count of nanos. A nil m is zero.
+func moneyNanos(m *banking.Money) *big.Int {
+	if m == nil {
+		return new(big.Int)
+	}
+	n := new(big.Int).Mul(big.NewInt(m.Units), bigNanosPerUnit)
+	return n.Add(n, big.NewInt(int64(m.Nanos)))
+}
+
+// moneyFromNanos converts a count of nanos back into Money, failing with
+// MoneyOverflowError if it does not fit.
+func moneyFromNanos(currencyCode string, n *big.Int) (*banking.Money, error) {
+	if n.Cmp(bigMaxNanos) > 0 || n.Cmp(bigMinNanos) < 0 {
+		return nil, MoneyOverflowError
+	}
+	units, nanos := new(big.Int).QuoRem(n, bigNanosPerUnit, new(big.Int))
+	return &banking.Money{
+		CurrencyCode: currencyCode,
+		Units:        units.Int64(),
+		Nanos:        int32(nanos.Int64()),
+	}, nil
+}
+
+// addMoney returns a+b. Both must be in the same currency.
+func addMoney(a, b *banking.Money) (*banking.Money, error) {
+	if a.CurrencyCode != b.CurrencyCode {
+		return nil, fmt.Errorf("%w: %s and %s", CurrencyMismatchError, a.CurrencyCode, b.CurrencyCode)
+	}
+	return moneyFromNanos(a.CurrencyCode, new(big.Int).Add(moneyNanos(a), moneyNanos(b)))
+}
+
+// subMoney returns a-b. Both must be in the same currency.
+func subMoney(a, b *banking.Money) (*banking.Money, error) {
+	if a.CurrencyCode != b.CurrencyCode {
+		return nil, fmt.Errorf("%w: %s and %s", CurrencyMismatchError, a.CurrencyCode, b.CurrencyCode)
+	}
+	return moneyFromNanos(a.CurrencyCode, new(big.Int).Sub(moneyNanos(a), moneyNanos(b)))
+}
+
+// mulMoney returns m*n.
+func mulMoney(m *banking.Money, n int64) (*banking.Money, error) {
+	return moneyFromNanos(m.CurrencyCode, new(big.Int).Mul(moneyNanos(m), big.NewInt(n)))
+}
+
+// convertMoney converts m to currency to at rate, rounding half to even at
+// nano precision.
+func convertMoney(m *banking.Money, to string, rate *big.Rat) (*banking.Money, error) {
+	r := new(big.Rat).Mul(new(big.Rat).SetInt(moneyNanos(m)), rate)
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	// Compare 2*|rem| against the denominator to round the quotient.
+	twice := new(big.Int).Abs(rem)
+	twice.Lsh(twice, 1)
+	if c := twice.Cmp(r.Denom()); c > 0 || (c == 0 && q.Bit(0) == 1) {
+		if rem.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return moneyFromNanos(to, q)
+}
+
+// formatMoney renders m as a decimal amount followed by its currency code,
+// e.g. "12.5 USD".
+func formatMoney(m *banking.Money) string {
+	if m == nil {
+		return "0"
+	}
+	sign := ""
+	units, nanos := m.Units, int64(m.Nanos)
+	if units < 0 || nanos < 0 {
+		sign = "-"
+		units, nanos = -units, -nanos
+	}
+	amount := fmt.Sprintf("%s%d", sign, uint64(units))
+	if nanos != 0 {
+		amount += "." + strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+	}
+	return amount + " " + m.CurrencyCode
+}
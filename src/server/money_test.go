@@ -0,0 +1,80 @@
+package server
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMoney(t *testing.T) {
+	assert.NoError(t, validateMoney(&banking.Money{CurrencyCode: "USD", Units: -1, Nanos: -500_000_000}))
+	assert.ErrorIs(t, validateMoney(nil), InvalidMoneyError)
+	assert.ErrorIs(t, validateMoney(&banking.Money{CurrencyCode: "usd"}), InvalidMoneyError)
+	assert.ErrorIs(t, validateMoney(&banking.Money{CurrencyCode: "USD", Nanos: 1_000_000_000}), InvalidMoneyError)
+	assert.ErrorIs(t, validateMoney(&banking.Money{CurrencyCode: "USD", Units: 1, Nanos: -1}), InvalidMoneyError)
+}
+
+func TestAddMoney(t *testing.T) {
+	sum, err := addMoney(
+		&banking.Money{CurrencyCode: "USD", Units: 1, Nanos: 750_000_000},
+		&banking.Money{CurrencyCode: "USD", Units: -3, Nanos: -500_000_000},
+	)
+	assert.NoError(t, err)
+	assertProtoEqual(t, &banking.Money{CurrencyCode: "USD", Units: -1, Nanos: -750_000_000}, sum)
+
+	_, err = addMoney(usd(1), &banking.Money{CurrencyCode: "EUR", Units: 1})
+	assert.ErrorIs(t, err, CurrencyMismatchError)
+}
+
+func TestAddMoney_Overflow(t *testing.T) {
+	_, err := addMoney(usd(math.MaxInt64), &banking.Money{CurrencyCode: "USD", Nanos: 999_999_999})
+	assert.NoError(t, err)
+
+	_, err = addMoney(usd(math.MaxInt64), usd(1))
+	assert.ErrorIs(t, err, MoneyOverflowError)
+
+	_, err = subMoney(usd(-math.MaxInt64), usd(1))
+	assert.ErrorIs(t, err, MoneyOverflowError)
+}
+
+func TestConvertMoney(t *testing.T) {
+	converted, err := convertMoney(usd(10), "JPY", big.NewRat(3, 2))
+	assert.NoError(t, err)
+	assertProtoEqual(t, &banking.Money{CurrencyCode: "JPY", Units: 15}, converted)
+
+	// 1 nano * 1/2 rounds half to even, down to 0; 3 nanos * 1/2 rounds up to 2.
+	converted, _ = convertMoney(&banking.Money{CurrencyCode: "USD", Nanos: 1}, "EUR", big.NewRat(1, 2))
+	assert.Equal(t, int32(0), converted.Nanos)
+	converted, _ = convertMoney(&banking.Money{CurrencyCode: "USD", Nanos: 3}, "EUR", big.NewRat(1, 2))
+	assert.Equal(t, int32(2), converted.Nanos)
+}
+
+func TestFormatMoney(t *testing.T) {
+	assert.Equal(t, "12.5 USD", formatMoney(&banking.Money{CurrencyCode: "USD", Units: 12, Nanos: 500_000_000}))
+	assert.Equal(t, "-0.01 EUR", formatMoney(&banking.Money{CurrencyCode: "EUR", Nanos: -10_000_000}))
+	assert.Equal(t, "100 USD", formatMoney(usd(100)))
+}
+
+func TestMoneyKey_Order(t *testing.T) {
+	sorted := []*banking.Money{
+		{CurrencyCode: "EUR", Units: 5},
+		{CurrencyCode: "USD", Units: math.MinInt64},
+		{CurrencyCode: "USD", Units: -256},
+		{CurrencyCode: "USD", Units: -255},
+		{CurrencyCode: "USD", Units: -1, Nanos: -1},
+		{CurrencyCode: "USD", Nanos: -1},
+		{CurrencyCode: "USD"},
+		{CurrencyCode: "USD", Nanos: 1},
+		{CurrencyCode: "USD", Units: 255},
+		{CurrencyCode: "USD", Units: 256},
+		{CurrencyCode: "USD", Units: math.MaxInt64, Nanos: 999_999_999},
+		{CurrencyCode: "USDT", Units: -5},
+	}
+	for i := 1; i < len(sorted); i++ {
+		assert.Negative(t, bytes.Compare(moneyKey(sorted[i-1]), moneyKey(sorted[i])), "%v before %v", sorted[i-1], sorted[i])
+	}
+}
@@ -0,0 +1,208 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"strings"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+
+	pageTokenFieldQuery protowire.Number = 1
+	pageTokenFieldKey   protowire.Number = 3
+	pageTokenFieldID    protowire.Number = 4
+)
+
+var InvalidPageTokenError = errors.New("Invalid page token")
+
+// A Cursor is the position of a record in an Ordering: its sort key and,
+// to break ties, its ID.
+type Cursor struct {
+	Key []byte
+	ID  string
+}
+
+func (c Cursor) compare(other Cursor) int {
+	if k := bytes.Compare(c.Key, other.Key); k != 0 {
+		return k
+	}
+	return strings.Compare(c.ID, other.ID)
+}
+
+// An Ordering is a strict order of records of type T by a key, encoded so
+// that keys compare bytewise, and then by ID. Stores may keep an index for
+// an ordering, found by its Name.
+type Ordering[T any] struct {
+	Name string
+	// Key returns the sort key of a record. If nil, records are ordered by
+	// ID alone.
+	Key func(T) []byte
+	ID  func(T) string
+	// Where, if set, limits the ordering to the records it holds for.
+	Where func(T) bool
+	Desc  bool
+}
+
+// holds reports whether record is in o.
+func (o Ordering[T]) holds(record T) bool {
+	return o.Where == nil || o.Where(record)
+}
+
+// cursor returns the position of record in o.
+func (o Ordering[T]) cursor(record T) Cursor {
+	c := Cursor{ID: o.ID(record)}
+	if o.Key != nil {
+		c.Key = o.Key(record)
+	}
+	return c
+}
+
+// A page token is opaque to clients. It holds a fingerprint of the request
+// it continues, so it cannot be replayed against different filters, and the
+// cursor of the last item returned. Pages resume strictly after that cursor,
+// which keeps pagination stable while items are added or removed.
+
+// listPage returns clones of the page of records matching match, in order,
+// that follows the page token of req, along with the token for the page
+// after it. scan is the store method that walks records in an ordering.
+func listPage[T proto.Message](
+	ctx context.Context, req proto.Message, order Ordering[T], match func(T) bool,
+	scan func(ctx context.Context, order Ordering[T], after *Cursor, fn func(T) bool) error,
+) ([]T, string, error) {
+	fingerprint, err := requestFingerprint(req, "pageToken", "pageSize")
+	if err != nil {
+		return nil, "", err
+	}
+	sum := sha256.Sum256(fingerprint)
+	query := sum[:16]
+	m := req.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	pageToken := m.Get(fields.ByName("pageToken")).String()
+	pageSize := int(m.Get(fields.ByName("pageSize")).Int())
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+
+	var after *Cursor
+	if pageToken != "" {
+		if after, err = decodePageToken(pageToken, query); err != nil {
+			return nil, "", err
+		}
+	}
+
+	var page []T
+	more := false
+	err = scan(ctx, order, after, func(record T) bool {
+		if !match(record) {
+			return true
+		}
+		if len(page) == pageSize {
+			more = true
+			return false
+		}
+		page = append(page, proto.Clone(record).(T))
+		return true
+	})
+	if err != nil || !more {
+		return page, "", err
+	}
+	return page, encodePageToken(query, order.cursor(page[len(page)-1])), nil
+}
+
+func encodePageToken(query []byte, last Cursor) string {
+	b := protowire.AppendTag(nil, pageTokenFieldQuery, protowire.BytesType)
+	b = protowire.AppendBytes(b, query)
+	b = protowire.AppendTag(b, pageTokenFieldKey, protowire.BytesType)
+	b = protowire.AppendBytes(b, last.Key)
+	b = protowire.AppendTag(b, pageTokenFieldID, protowire.BytesType)
+	b = protowire.AppendBytes(b, []byte(last.ID))
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodePageToken checks that token was issued for query and returns the
+// cursor of the last item it covers.
+func decodePageToken(token string, query []byte) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, InvalidPageTokenError
+	}
+	var tokenQuery, key, id []byte
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 || typ != protowire.BytesType {
+			return nil, InvalidPageTokenError
+		}
+		b = b[n:]
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return nil, InvalidPageTokenError
+		}
+		b = b[n:]
+		switch num {
+		case pageTokenFieldQuery:
+			tokenQuery = v
+		case pageTokenFieldKey:
+			key = v
+		case pageTokenFieldID:
+			id = v
+		}
+	}
+	if len(id) == 0 || !bytes.Equal(tokenQuery, query) {
+		return nil, InvalidPageTokenError
+	}
+	return &Cursor{Key: key, ID: string(id)}, nil
+}
+
+// timeKey encodes ts as a sort key, ordering nil before every time.
+func timeKey(ts *timestamppb.Timestamp) []byte {
+	if ts == nil {
+		return nil
+	}
+	b := binary.BigEndian.AppendUint64(nil, uint64(ts.Seconds)^1<<63)
+	return binary.BigEndian.AppendUint32(b, uint32(ts.Nanos))
+}
+
+// moneyKey encodes m as a sort key, ordering by currency and then amount.
+func moneyKey(m *banking.Money) []byte {
+	b := append([]byte(m.GetCurrencyCode()), 0)
+	n := moneyNanos(m)
+	magnitude := n.Bytes()
+	if n.Sign() >= 0 {
+		b = append(b, 1, byte(len(magnitude)))
+		return append(b, magnitude...)
+	}
+	// Larger magnitudes sort first among negative amounts.
+	b = append(b, 0, byte(255-len(magnitude)))
+	for _, x := range magnitude {
+		b = append(b, ^x)
+	}
+	return b
+}
+
+// requestFingerprint is a deterministic encoding of req with the named
+// fields cleared.
+func requestFingerprint(req proto.Message, ignore ...protoreflect.Name) ([]byte, error) {
+	req = proto.Clone(req)
+	m := req.ProtoReflect()
+	for _, name := range ignore {
+		if fd := m.Descriptor().Fields().ByName(name); fd != nil {
+			m.Clear(fd)
+		}
+	}
+	return proto.MarshalOptions{Deterministic: true}.Marshal(req)
+}
@@ -0,0 +1,298 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// recurrence yields the times at which a recurring schedule runs. Times are
+// in UTC.
+type recurrence interface {
+	// next returns the first run strictly after t, or false if there are no
+	// more runs.
+	next(t time.Time) (time.Time, bool)
+}
+
+// parseRecurrence parses expr, which is either a five field cron expression
+// or an RFC 5545 RRULE, optionally prefixed with "RRULE:". start is the
+// DTSTART of an RRULE; cron expressions ignore it.
+func parseRecurrence(expr string, start time.Time) (recurrence, error) {
+	expr = strings.TrimSpace(expr)
+	upper := strings.ToUpper(expr)
+	if strings.HasPrefix(upper, "RRULE:") || strings.HasPrefix(upper, "FREQ=") || strings.Contains(upper, ";FREQ=") {
+		return parseRRule(strings.TrimPrefix(upper, "RRULE:"), start)
+	}
+	return parseCron(expr)
+}
+
+// cronSchedule is a parsed cron expression. Each field is a bitset of the
+// values it matches.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	// Vixie cron semantics: when both day fields are restricted a day
+	// matching either is enough.
+	domStar, dowStar bool
+}
+
+// maxCronSearch bounds how far ahead next looks for a matching minute, so
+// that expressions such as "0 0 30 2 *" that never match terminate.
+const maxCronSearch = 5 * 366 * 24 * time.Hour
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var cronMonthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+var cronDayNames = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+func parseCron(expr string) (*cronSchedule, error) {
+	if macro, ok := cronMacros[strings.ToLower(expr)]; ok {
+		expr = macro
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression must have 5 fields, got %d", len(fields))
+	}
+	c := &cronSchedule{
+		domStar: strings.HasPrefix(fields[2], "*"),
+		dowStar: strings.HasPrefix(fields[4], "*"),
+	}
+	var err error
+	if c.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, fmt.Errorf("minute: %w", err)
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, fmt.Errorf("hour: %w", err)
+	}
+	if c.dom, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, fmt.Errorf("day of month: %w", err)
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, fmt.Errorf("month: %w", err)
+	}
+	if c.dow, err = parseCronField(fields[4], 0, 7, cronDayNames); err != nil {
+		return nil, fmt.Errorf("day of week: %w", err)
+	}
+	// Both 0 and 7 are Sunday.
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	return c, nil
+}
+
+// parseCronField parses a comma separated list of values, ranges and steps,
+// e.g. "1,15", "9-17" or "*/10", into a bitset.
+func parseCronField(field string, min, max int, names map[string]int) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("bad step in %q", part)
+			}
+			step, part = n, part[:i]
+		}
+		lo, hi := min, max
+		if part != "*" {
+			from, to, isRange := strings.Cut(part, "-")
+			var err error
+			if lo, err = parseCronValue(from, names); err != nil {
+				return 0, err
+			}
+			switch {
+			case isRange:
+				if hi, err = parseCronValue(to, names); err != nil {
+					return 0, err
+				}
+			case step == 1:
+				hi = lo
+			}
+			if lo < min || hi > max || lo > hi {
+				return 0, fmt.Errorf("%q is outside %d-%d", part, min, max)
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+func parseCronValue(s string, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("bad value %q", s)
+	}
+	return v, nil
+}
+
+func (c *cronSchedule) next(t time.Time) (time.Time, bool) {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxCronSearch)
+	for t.Before(limit) {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (c *cronSchedule) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// rrule is the subset of an RFC 5545 recurrence rule made of FREQ,
+// INTERVAL, COUNT and UNTIL. Occurrences that fall on dates that do not
+// exist, such as the 31st of a shorter month, are skipped and do not count
+// towards COUNT.
+type rrule struct {
+	start    time.Time
+	freq     string
+	interval int
+	count    int
+	until    time.Time
+}
+
+var rruleFixedPeriods = map[string]time.Duration{
+	"HOURLY": time.Hour,
+	"DAILY":  24 * time.Hour,
+	"WEEKLY": 7 * 24 * time.Hour,
+}
+
+// maxRRuleOccurrences bounds the search for monthly and yearly occurrences.
+const maxRRuleOccurrences = 100000
+
+func parseRRule(expr string, start time.Time) (*rrule, error) {
+	r := &rrule{start: start.UTC(), interval: 1}
+	for _, part := range strings.Split(expr, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("bad RRULE part %q", part)
+		}
+		var err error
+		switch key {
+		case "FREQ":
+			switch value {
+			case "HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+				r.freq = value
+			default:
+				return nil, fmt.Errorf("unsupported FREQ %q", value)
+			}
+		case "INTERVAL":
+			if r.interval, err = strconv.Atoi(value); err != nil || r.interval <= 0 {
+				return nil, fmt.Errorf("bad INTERVAL %q", value)
+			}
+		case "COUNT":
+			if r.count, err = strconv.Atoi(value); err != nil || r.count <= 0 {
+				return nil, fmt.Errorf("bad COUNT %q", value)
+			}
+		case "UNTIL":
+			if r.until, err = parseRRuleTime(value); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unsupported RRULE part %s", key)
+		}
+	}
+	if r.freq == "" {
+		return nil, errors.New("RRULE must have a FREQ")
+	}
+	if r.count > 0 && !r.until.IsZero() {
+		return nil, errors.New("RRULE cannot have both COUNT and UNTIL")
+	}
+	return r, nil
+}
+
+func parseRRuleTime(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("bad UNTIL %q", value)
+}
+
+func (r *rrule) next(t time.Time) (time.Time, bool) {
+	if period, ok := rruleFixedPeriods[r.freq]; ok {
+		// Every occurrence exists, so the one after t can be computed.
+		period *= time.Duration(r.interval)
+		k := 0
+		if !t.Before(r.start) {
+			k = int(t.Sub(r.start)/period) + 1
+		}
+		if r.count > 0 && k >= r.count {
+			return time.Time{}, false
+		}
+		return r.bounded(r.start.Add(time.Duration(k) * period))
+	}
+
+	found := 0
+	for k := 0; k < maxRRuleOccurrences; k++ {
+		occ, ok := r.occurrence(k)
+		if !ok {
+			continue
+		}
+		if found++; r.count > 0 && found > r.count {
+			break
+		}
+		if occ.After(t) {
+			return r.bounded(occ)
+		}
+		if !r.until.IsZero() && occ.After(r.until) {
+			break
+		}
+	}
+	return time.Time{}, false
+}
+
+// occurrence returns the k'th monthly or yearly candidate, or false if that
+// date does not exist.
+func (r *rrule) occurrence(k int) (time.Time, bool) {
+	months := k * r.interval
+	if r.freq == "YEARLY" {
+		months *= 12
+	}
+	s := r.start
+	occ := time.Date(s.Year(), s.Month()+time.Month(months), s.Day(), s.Hour(), s.Minute(), s.Second(), s.Nanosecond(), time.UTC)
+	return occ, occ.Day() == s.Day()
+}
+
+func (r *rrule) bounded(t time.Time) (time.Time, bool) {
+	if !r.until.IsZero() && t.After(r.until) {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func utc(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// runs returns the first n runs of rec after t.
+func runs(rec recurrence, t time.Time, n int) []time.Time {
+	var out []time.Time
+	for i := 0; i < n; i++ {
+		next, ok := rec.next(t)
+		if !ok {
+			break
+		}
+		out = append(out, next)
+		t = next
+	}
+	return out
+}
+
+func TestParseRecurrence_Cron(t *testing.T) {
+	tests := []struct {
+		expr  string
+		after string
+		want  []string
+	}{
+		{"0 9 1 * *", "2024-01-15T00:00:00Z", []string{"2024-02-01T09:00:00Z", "2024-03-01T09:00:00Z"}},
+		{"*/20 * * * *", "2024-01-01T10:05:30Z", []string{"2024-01-01T10:20:00Z", "2024-01-01T10:40:00Z", "2024-01-01T11:00:00Z"}},
+		{"30 8 * * MON-FRI", "2024-01-05T09:00:00Z", []string{"2024-01-08T08:30:00Z", "2024-01-09T08:30:00Z"}},
+		{"0 0 * * 7", "2024-01-01T00:00:00Z", []string{"2024-01-07T00:00:00Z"}},
+		// Restricting both day fields matches either.
+		{"0 0 13 * FRI", "2024-09-01T00:00:00Z", []string{"2024-09-06T00:00:00Z", "2024-09-13T00:00:00Z", "2024-09-20T00:00:00Z"}},
+		{"0 0 29 FEB *", "2024-03-01T00:00:00Z", []string{"2028-02-29T00:00:00Z"}},
+		{"@yearly", "2024-06-01T00:00:00Z", []string{"2025-01-01T00:00:00Z"}},
+		{"0 0 31 2 *", "2024-01-01T00:00:00Z", nil},
+	}
+	for _, tt := range tests {
+		rec, err := parseRecurrence(tt.expr, time.Time{})
+		require.NoError(t, err, tt.expr)
+		var want []time.Time
+		for _, s := range tt.want {
+			want = append(want, utc(s))
+		}
+		assert.Equal(t, want, runs(rec, utc(tt.after), max(len(want), 1)), tt.expr)
+	}
+}
+
+func TestParseRecurrence_RRule(t *testing.T) {
+	start := utc("2024-01-31T09:00:00Z")
+	// Each rule is asked for one more run than it has, except the last which
+	// never ends.
+	tests := []struct {
+		expr string
+		n    int
+		want []string
+	}{
+		{"FREQ=DAILY;INTERVAL=2;COUNT=3", 4, []string{"2024-01-31T09:00:00Z", "2024-02-02T09:00:00Z", "2024-02-04T09:00:00Z"}},
+		// Months without a 31st are skipped and do not count.
+		{"RRULE:FREQ=MONTHLY;COUNT=3", 4, []string{"2024-01-31T09:00:00Z", "2024-03-31T09:00:00Z", "2024-05-31T09:00:00Z"}},
+		{"FREQ=WEEKLY;UNTIL=20240215T000000Z", 4, []string{"2024-01-31T09:00:00Z", "2024-02-07T09:00:00Z", "2024-02-14T09:00:00Z"}},
+		{"freq=yearly", 3, []string{"2024-01-31T09:00:00Z", "2025-01-31T09:00:00Z", "2026-01-31T09:00:00Z"}},
+	}
+	for _, tt := range tests {
+		rec, err := parseRecurrence(tt.expr, start)
+		require.NoError(t, err, tt.expr)
+		var want []time.Time
+		for _, s := range tt.want {
+			want = append(want, utc(s))
+		}
+		assert.Equal(t, want, runs(rec, start.Add(-time.Nanosecond), tt.n), tt.expr)
+	}
+}
+
+func TestParseRecurrence_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* * * * MON-",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"FREQ=SECONDLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;BYDAY=MO",
+		"FREQ=DAILY;COUNT=2;UNTIL=20250101",
+		"INTERVAL=2;FREQ=",
+	} {
+		_, err := parseRecurrence(expr, time.Now())
+		assert.Error(t, err, expr)
+	}
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ReverseTransaction(ctx context.Context, req *banking.ReverseTransactionRequest) (*banking.TransactionResponse, error) {
+	return idempotent(s.idempotency, ctx, s.IdempotencyTTL, "ReverseTransaction", req.IdempotencyKey, req,
+		func() (*banking.TransactionResponse, error) { return s.reverseTransaction(ctx, req) })
+}
+
+func (s *Server) reverseTransaction(ctx context.Context, req *banking.ReverseTransactionRequest) (*banking.TransactionResponse, error) {
+	original, err := s.store.GetTransaction(ctx, req.TransactionId)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	if original.ReversalOf != "" {
+		return nil, statusError(fmt.Errorf("%w: %s is itself a reversal", TransactionNotReversibleError, original.TransactionId))
+	}
+
+	refund := req.Amount
+	if refund == nil {
+		if refund, err = unreversed(original); err != nil {
+			return nil, statusError(err)
+		}
+	} else if err := validateMoney(refund); err != nil {
+		return nil, invalidArgument(FieldViolation{"amount", err.Error()})
+	} else if refund.CurrencyCode != original.Amount.CurrencyCode {
+		return nil, invalidArgument(FieldViolation{"amount", "must be in " + original.Amount.CurrencyCode})
+	}
+
+	reversal := &banking.Transaction{
+		FromAccountId: original.ToAccountId,
+		ToAccountId:   original.FromAccountId,
+		Amount:        refund,
+		CreatedAt:     timestamppb.Now(),
+		Status:        banking.TransactionStatus_TRANSACTION_STATUS_POSTED,
+		ReversalOf:    original.TransactionId,
+		Reason:        req.Reason,
+	}
+	if original.CreditAmount != nil {
+		// Take back the share of the credit being refunded, at the rate the
+		// original was converted at.
+		rate := new(big.Rat).SetFrac(moneyNanos(original.CreditAmount), moneyNanos(original.Amount))
+		if reversal.Amount, err = convertMoney(refund, original.CreditAmount.CurrencyCode, rate); err != nil {
+			return nil, statusError(err)
+		}
+		reversal.CreditAmount = refund
+		reversal.ExchangeRate = rate.Inv(rate).FloatString(9)
+	}
+
+	if err := s.transfer(ctx, reversal); err != nil {
+		return nil, statusError(err)
+	}
+
+	return s.transactionPosted(ctx, reversal), nil
+}
+
+// unreversed returns how much of tx has not been reversed yet.
+func unreversed(tx *banking.Transaction) (*banking.Money, error) {
+	if tx.ReversedAmount == nil {
+		return tx.Amount, nil
+	}
+	remaining, err := subMoney(tx.Amount, tx.ReversedAmount)
+	if err != nil {
+		return nil, err
+	}
+	if moneyNanos(remaining).Sign() <= 0 {
+		return nil, fmt.Errorf("%w: %s is already fully reversed", ReversalExceedsOriginalError, tx.TransactionId)
+	}
+	return remaining, nil
+}
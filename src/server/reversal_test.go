@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func transactionDetails(t *testing.T, s *Server, id string) *banking.Transaction {
+	res, err := s.GetTransactionDetails(context.Background(), &banking.TransactionDetailsRequest{TransactionId: id})
+	require.NoError(t, err)
+	return res.Transaction
+}
+
+func TestReverseTransaction_Partial(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0)
+	ids := makeTestTransfers(t, s, accounts[0], accounts[1], 50)
+
+	res, err := s.ReverseTransaction(context.Background(), &banking.ReverseTransactionRequest{
+		TransactionId: ids[0],
+		Amount:        usd(20),
+		Reason:        "damaged goods",
+	})
+	require.NoError(t, err)
+
+	reversal := transactionDetails(t, s, res.TransactionId)
+	assert.Equal(t, ids[0], reversal.ReversalOf)
+	assert.Equal(t, accounts[1], reversal.FromAccountId)
+	assert.Equal(t, accounts[0], reversal.ToAccountId)
+	assert.Equal(t, "damaged goods", reversal.Reason)
+	original := transactionDetails(t, s, ids[0])
+	assert.Equal(t, banking.TransactionStatus_TRANSACTION_STATUS_PARTIALLY_REVERSED, original.Status)
+	assertProtoEqual(t, usd(20), original.ReversedAmount)
+
+	_, err = s.ReverseTransaction(context.Background(), &banking.ReverseTransactionRequest{
+		TransactionId: ids[0],
+		Amount:        usd(31),
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, ReasonReversalExceedsOriginal, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+
+	// Without an amount the remainder is reversed.
+	_, err = s.ReverseTransaction(context.Background(), &banking.ReverseTransactionRequest{TransactionId: ids[0]})
+	require.NoError(t, err)
+	original = transactionDetails(t, s, ids[0])
+	assert.Equal(t, banking.TransactionStatus_TRANSACTION_STATUS_REVERSED, original.Status)
+	balance, _ := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: accounts[0]})
+	assertProtoEqual(t, usd(100), balance.Balance)
+
+	_, err = s.ReverseTransaction(context.Background(), &banking.ReverseTransactionRequest{TransactionId: ids[0]})
+	assert.Equal(t, ReasonReversalExceedsOriginal, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+	_, err = s.ReverseTransaction(context.Background(), &banking.ReverseTransactionRequest{TransactionId: res.TransactionId})
+	assert.Equal(t, ReasonTransactionNotReversible, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+}
+
+func TestReverseTransaction_CrossCurrency(t *testing.T) {
+	s := getNewTestServer()
+	s.Rates = StaticRates{"USD/EUR": big.NewRat(9, 10)}
+	accounts := createTestAccounts(t, s, 100)
+	eur, err := s.CreateAccount(context.Background(), &banking.AccountRequest{
+		InitialBalance: &banking.Money{CurrencyCode: "EUR", Units: 0},
+	})
+	require.NoError(t, err)
+	ids := makeTestTransfers(t, s, accounts[0], eur.AccountId, 10)
+
+	res, err := s.ReverseTransaction(context.Background(), &banking.ReverseTransactionRequest{
+		TransactionId: ids[0],
+		Amount:        usd(5),
+	})
+	require.NoError(t, err)
+
+	reversal := transactionDetails(t, s, res.TransactionId)
+	assertProtoEqual(t, &banking.Money{CurrencyCode: "EUR", Units: 4, Nanos: 500_000_000}, reversal.Amount)
+	assertProtoEqual(t, usd(5), reversal.CreditAmount)
+	balance, _ := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: accounts[0]})
+	assertProtoEqual(t, usd(95), balance.Balance)
+
+	_, err = s.ReverseTransaction(context.Background(), &banking.ReverseTransactionRequest{
+		TransactionId: ids[0],
+		Amount:        &banking.Money{CurrencyCode: "EUR", Units: 1},
+	})
+	assert.Contains(t, violatedFields(t, err), "amount")
+}
+
+func TestReverseTransaction_NotFound(t *testing.T) {
+	s := getNewTestServer()
+	_, err := s.ReverseTransaction(context.Background(), &banking.ReverseTransactionRequest{TransactionId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,346 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxScheduleRuns bounds how many runs of one schedule are collected in a
+// single pass, so that a frequent schedule that was down for a long time
+// catches up over several passes rather than stalling the others.
+const maxScheduleRuns = 1000
+
+func (s *Server) ScheduleTransfer(ctx context.Context, req *banking.ScheduleTransferRequest) (*banking.ScheduleResponse, error) {
+	return idempotent(s.idempotency, ctx, s.IdempotencyTTL, "ScheduleTransfer", req.IdempotencyKey, req,
+		func() (*banking.ScheduleResponse, error) { return s.scheduleTransfer(ctx, req) })
+}
+
+func (s *Server) scheduleTransfer(ctx context.Context, req *banking.ScheduleTransferRequest) (*banking.ScheduleResponse, error) {
+	now := time.Now()
+	start := now
+	var violations []FieldViolation
+	if req.StartAt != nil {
+		if err := req.StartAt.CheckValid(); err != nil {
+			violations = append(violations, FieldViolation{"startAt", err.Error()})
+		}
+		start = req.StartAt.AsTime()
+	} else if req.Recurrence == "" {
+		violations = append(violations, FieldViolation{"startAt", "is required for a one-off transfer"})
+	}
+	if req.EndAt != nil {
+		switch err := req.EndAt.CheckValid(); {
+		case req.Recurrence == "":
+			violations = append(violations, FieldViolation{"endAt", "only applies to recurring transfers"})
+		case err != nil:
+			violations = append(violations, FieldViolation{"endAt", err.Error()})
+		case req.EndAt.AsTime().Before(start):
+			violations = append(violations, FieldViolation{"endAt", "must not be before startAt"})
+		}
+	}
+
+	schedule := &banking.Schedule{
+		ScheduleId:    uuid.New().String(),
+		FromAccountId: req.FromAccountId,
+		ToAccountId:   req.ToAccountId,
+		Amount:        req.Amount,
+		Memo:          req.Memo,
+		Reference:     req.Reference,
+		StartAt:       timestamppb.New(start),
+		Recurrence:    req.Recurrence,
+		EndAt:         req.EndAt,
+		CatchUpPolicy: req.CatchUpPolicy,
+		Status:        banking.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+		CreatedAt:     timestamppb.New(now),
+		UpdatedAt:     timestamppb.New(now),
+	}
+	rec, err := scheduleRecurrence(schedule)
+	if err != nil {
+		violations = append(violations, FieldViolation{"recurrence", err.Error()})
+	}
+	if len(violations) > 0 {
+		return nil, invalidArgument(violations...)
+	}
+
+	// A recurrence starts with its first run at or after startAt, but never
+	// with runs that were already due before it was created.
+	first := start
+	if rec != nil {
+		if now.After(first) {
+			first = now
+		}
+		var ok bool
+		if first, ok = nextRun(schedule, rec, first.Add(-time.Nanosecond)); !ok {
+			return nil, invalidArgument(FieldViolation{"recurrence", "has no runs after startAt"})
+		}
+	}
+	schedule.NextRunAt = timestamppb.New(first)
+
+	// Check now that the transfer could be made, rather than leaving the
+	// first run to find out.
+	if _, err := s.newTransaction(ctx, scheduledTransferRequest(schedule)); err != nil {
+		return nil, err
+	}
+
+	if err := s.store.CreateSchedule(ctx, schedule); err != nil {
+		return nil, statusError(err)
+	}
+
+	s.logger(ctx).DebugContext(ctx, "Scheduled transfer",
+		"scheduleId", schedule.ScheduleId,
+		"fromAccountId", schedule.FromAccountId,
+		"recurrence", schedule.Recurrence,
+		"nextRunAt", first,
+	)
+
+	return &banking.ScheduleResponse{Schedule: schedule}, nil
+}
+
+func (s *Server) ListSchedules(ctx context.Context, req *banking.ListSchedulesRequest) (*banking.ListSchedulesResponse, error) {
+	match := func(schedule *banking.Schedule) bool {
+		switch {
+		case req.AccountId != "" && schedule.FromAccountId != req.AccountId && schedule.ToAccountId != req.AccountId:
+			return false
+		case req.Status != banking.ScheduleStatus_SCHEDULE_STATUS_UNSPECIFIED && schedule.Status != req.Status:
+			return false
+		}
+		return true
+	}
+	page, next, err := listPage(ctx, req, schedulesByCreatedAt, match, s.store.ScanSchedules)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	s.logger(ctx).DebugContext(ctx, "Listed schedules", "accountId", req.AccountId, "schedules", len(page))
+
+	return &banking.ListSchedulesResponse{Schedules: page, NextPageToken: next}, nil
+}
+
+func (s *Server) CancelSchedule(ctx context.Context, req *banking.CancelScheduleRequest) (*banking.ScheduleResponse, error) {
+	schedule, err := s.store.UpdateSchedule(ctx, req.ScheduleId, func(schedule *banking.Schedule) error {
+		if schedule.Status != banking.ScheduleStatus_SCHEDULE_STATUS_ACTIVE {
+			return &ResourceError{Err: ScheduleNotActiveError, ResourceType: scheduleResourceType, Name: schedule.ScheduleId}
+		}
+		schedule.Status = banking.ScheduleStatus_SCHEDULE_STATUS_CANCELLED
+		schedule.CancelReason = req.Reason
+		schedule.NextRunAt = nil
+		return nil
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	s.logger(ctx).DebugContext(ctx, "Cancelled schedule", "scheduleId", req.ScheduleId, "reason", req.Reason)
+
+	return &banking.ScheduleResponse{Schedule: schedule}, nil
+}
+
+// schedulesByCreatedAt orders schedules by creation time, breaking ties by
+// ID.
+var schedulesByCreatedAt = Ordering[*banking.Schedule]{
+	Name: "createdAt",
+	Key:  func(schedule *banking.Schedule) []byte { return timeKey(schedule.CreatedAt) },
+	ID:   scheduleID,
+}
+
+// schedulesByNextRun orders the active schedules by when they next run,
+// which is the order the scheduler makes them in.
+var schedulesByNextRun = Ordering[*banking.Schedule]{
+	Name: "nextRunAt",
+	Key:  func(schedule *banking.Schedule) []byte { return timeKey(schedule.NextRunAt) },
+	ID:   scheduleID,
+	Where: func(schedule *banking.Schedule) bool {
+		return schedule.Status == banking.ScheduleStatus_SCHEDULE_STATUS_ACTIVE
+	},
+}
+
+func scheduleID(schedule *banking.Schedule) string { return schedule.ScheduleId }
+
+// scheduleRecurrence parses the recurrence of schedule, returning nil for a
+// one-off transfer.
+func scheduleRecurrence(schedule *banking.Schedule) (recurrence, error) {
+	if schedule.Recurrence == "" {
+		return nil, nil
+	}
+	return parseRecurrence(schedule.Recurrence, schedule.StartAt.AsTime())
+}
+
+// nextRun returns the run of schedule after t, or false if there is none.
+func nextRun(schedule *banking.Schedule, rec recurrence, t time.Time) (time.Time, bool) {
+	if rec == nil {
+		return time.Time{}, false
+	}
+	next, ok := rec.next(t)
+	if !ok || (schedule.EndAt != nil && next.After(schedule.EndAt.AsTime())) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+func scheduledTransferRequest(schedule *banking.Schedule) *banking.TransactionRequest {
+	return &banking.TransactionRequest{
+		FromAccountId: schedule.FromAccountId,
+		ToAccountId:   schedule.ToAccountId,
+		Amount:        schedule.Amount,
+		Memo:          schedule.Memo,
+		Reference:     schedule.Reference,
+	}
+}
+
+// runSchedules makes due scheduled transfers every ScheduleInterval until
+// done is closed. since is when the server started: runs that fell due
+// before it were missed while the server was down.
+func (s *Server) runSchedules(done <-chan struct{}, since time.Time) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.ScheduleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			ctx, span := tracer.Start(context.Background(), "Server.runDueSchedules")
+			s.runDueSchedules(ctx, since, now)
+			span.End()
+		}
+	}
+}
+
+// runDueSchedules makes every scheduled transfer due by now.
+func (s *Server) runDueSchedules(ctx context.Context, since, now time.Time) {
+	// Take copies, as the runs update the schedules in the store.
+	var due []*banking.Schedule
+	err := s.store.ScanSchedules(ctx, schedulesByNextRun, nil, func(schedule *banking.Schedule) bool {
+		if schedule.NextRunAt.AsTime().After(now) {
+			return false
+		}
+		due = append(due, proto.Clone(schedule).(*banking.Schedule))
+		return true
+	})
+	if err != nil {
+		s.Logger.Error("Failed to list schedules", "error", err)
+		return
+	}
+	for _, schedule := range due {
+		if err := s.runSchedule(ctx, schedule, since, now); err != nil {
+			s.Logger.Error("Failed to run schedule", "scheduleId", schedule.ScheduleId, "error", err)
+		}
+	}
+}
+
+// runSchedule makes the transfers of schedule due by now. The schedule is
+// advanced before any transfer is made, so a crash part way through can
+// drop a run but never repeat one.
+func (s *Server) runSchedule(ctx context.Context, schedule *banking.Schedule, since, now time.Time) error {
+	rec, err := scheduleRecurrence(schedule)
+	if err != nil {
+		return err
+	}
+
+	var due []time.Time
+	next, more := schedule.NextRunAt.AsTime(), true
+	for more && !next.After(now) && len(due) < maxScheduleRuns {
+		due = append(due, next)
+		next, more = nextRun(schedule, rec, next)
+	}
+	// Runs that fell due while the server was down are subject to the
+	// catch-up policy. Those due before the schedule existed were never
+	// missed, as it would not have run them.
+	missed := 0
+	if schedule.CreatedAt.AsTime().Before(since) {
+		for missed < len(due) && due[missed].Before(since) {
+			missed++
+		}
+	}
+	policy := schedule.CatchUpPolicy
+	if policy == banking.CatchUpPolicy_CATCH_UP_POLICY_UNSPECIFIED {
+		policy = s.ScheduleCatchUp
+	}
+	// transfers holds how many runs each transfer makes. Missed runs were
+	// due first, so they are made first.
+	var transfers []int64
+	switch policy {
+	case banking.CatchUpPolicy_CATCH_UP_POLICY_RUN_ALL:
+		// Every missed run is made as if it were on time.
+		missed = 0
+	case banking.CatchUpPolicy_CATCH_UP_POLICY_RUN_ONCE:
+		if missed > 0 {
+			transfers = append(transfers, int64(missed))
+		}
+	}
+	for range due[missed:] {
+		transfers = append(transfers, 1)
+	}
+
+	claimed := schedule.NextRunAt
+	schedule, err = s.store.UpdateSchedule(ctx, schedule.ScheduleId, func(schedule *banking.Schedule) error {
+		if schedule.Status != banking.ScheduleStatus_SCHEDULE_STATUS_ACTIVE || !proto.Equal(schedule.NextRunAt, claimed) {
+			// Cancelled or already run since it was listed.
+			return ScheduleNotActiveError
+		}
+		if more {
+			schedule.NextRunAt = timestamppb.New(next)
+		} else {
+			schedule.NextRunAt = nil
+			schedule.Status = banking.ScheduleStatus_SCHEDULE_STATUS_COMPLETED
+		}
+		if len(transfers) > 0 {
+			schedule.RunCount += int64(len(transfers))
+			schedule.LastRunAt = timestamppb.New(now)
+		}
+		return nil
+	})
+	if errors.Is(err, ScheduleNotActiveError) {
+		return nil
+	}
+	if err != nil || len(transfers) == 0 {
+		return err
+	}
+
+	var lastID, lastError string
+	for _, times := range transfers {
+		if lastID, err = s.scheduledTransfer(ctx, schedule, times); err != nil {
+			lastError = status.Convert(statusError(err)).Message()
+		} else {
+			lastError = ""
+		}
+	}
+	_, err = s.store.UpdateSchedule(ctx, schedule.ScheduleId, func(schedule *banking.Schedule) error {
+		schedule.LastTransactionId = lastID
+		schedule.LastError = lastError
+		return nil
+	})
+	return err
+}
+
+// scheduledTransfer makes times runs of schedule together, in one transfer
+// the way MakeTransaction would, returning the ID of the transaction.
+func (s *Server) scheduledTransfer(ctx context.Context, schedule *banking.Schedule, times int64) (string, error) {
+	req := scheduledTransferRequest(schedule)
+	if times != 1 {
+		amount, err := mulMoney(req.Amount, times)
+		if err != nil {
+			return "", err
+		}
+		req.Amount = amount
+	}
+	transaction, err := s.newTransaction(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	transaction.ScheduleId = schedule.ScheduleId
+
+	if err := s.transfer(ctx, transaction); err != nil {
+		s.recordFailure(ctx, transaction, err)
+		return "", err
+	}
+
+	s.transactionPosted(ctx, transaction)
+	return transaction.TransactionId, nil
+}
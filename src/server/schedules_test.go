@@ -0,0 +1,264 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func scheduleDetails(t *testing.T, s *Server, id string) *banking.Schedule {
+	schedule, err := s.store.GetSchedule(context.Background(), id)
+	require.NoError(t, err)
+	return schedule
+}
+
+func TestScheduleTransfer_OneOff(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0)
+	at := time.Now().Add(time.Hour)
+
+	res, err := s.ScheduleTransfer(context.Background(), &banking.ScheduleTransferRequest{
+		FromAccountId: accounts[0],
+		ToAccountId:   accounts[1],
+		Amount:        usd(30),
+		Reference:     "invoice-7",
+		StartAt:       timestamppb.New(at),
+	})
+	require.NoError(t, err)
+	id := res.Schedule.ScheduleId
+	assert.Equal(t, banking.ScheduleStatus_SCHEDULE_STATUS_ACTIVE, res.Schedule.Status)
+	assert.True(t, at.Equal(res.Schedule.NextRunAt.AsTime()))
+
+	s.runDueSchedules(context.Background(), time.Now(), at.Add(-time.Second))
+	assert.Zero(t, scheduleDetails(t, s, id).RunCount)
+
+	s.runDueSchedules(context.Background(), time.Now(), at)
+	schedule := scheduleDetails(t, s, id)
+	assert.Equal(t, banking.ScheduleStatus_SCHEDULE_STATUS_COMPLETED, schedule.Status)
+	assert.Nil(t, schedule.NextRunAt)
+	assert.Equal(t, int64(1), schedule.RunCount)
+	require.NotEmpty(t, schedule.LastTransactionId)
+
+	tx := transactionDetails(t, s, schedule.LastTransactionId)
+	assert.Equal(t, id, tx.ScheduleId)
+	assert.Equal(t, "invoice-7", tx.Reference)
+	assertProtoEqual(t, usd(30), tx.Amount)
+	ledger, _ := balances(t, s, accounts[1])
+	assertProtoEqual(t, usd(30), ledger)
+}
+
+func TestScheduleTransfer_RecordsFailedRuns(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 10, 0)
+	start := time.Now().Add(time.Minute)
+
+	res, err := s.ScheduleTransfer(context.Background(), &banking.ScheduleTransferRequest{
+		FromAccountId: accounts[0],
+		ToAccountId:   accounts[1],
+		Amount:        usd(30),
+		StartAt:       timestamppb.New(start),
+		Recurrence:    "FREQ=DAILY",
+	})
+	require.NoError(t, err)
+
+	s.runDueSchedules(context.Background(), time.Now(), start)
+	schedule := scheduleDetails(t, s, res.Schedule.ScheduleId)
+	assert.Equal(t, banking.ScheduleStatus_SCHEDULE_STATUS_ACTIVE, schedule.Status)
+	assert.Equal(t, int64(1), schedule.RunCount)
+	assert.Empty(t, schedule.LastTransactionId)
+	assert.Contains(t, schedule.LastError, "Insufficient funds")
+	assert.True(t, start.Add(24*time.Hour).Equal(schedule.NextRunAt.AsTime()))
+
+	failed, err := s.ListTransactions(context.Background(), &banking.ListTransactionsRequest{
+		Status: banking.TransactionStatus_TRANSACTION_STATUS_FAILED,
+	})
+	require.NoError(t, err)
+	require.Len(t, failed.Transactions, 1)
+	assert.Equal(t, schedule.ScheduleId, failed.Transactions[0].ScheduleId)
+}
+
+func TestScheduleTransfer_CatchUp(t *testing.T) {
+	tests := []struct {
+		policy banking.CatchUpPolicy
+		runs   int64
+		// amounts are the transfers made, in units.
+		amounts []int64
+	}{
+		{banking.CatchUpPolicy_CATCH_UP_POLICY_SKIP, 0, nil},
+		{banking.CatchUpPolicy_CATCH_UP_POLICY_RUN_ONCE, 1, []int64{40}},
+		{banking.CatchUpPolicy_CATCH_UP_POLICY_RUN_ALL, 4, []int64{10, 10, 10, 10}},
+		// The server default is RUN_ONCE.
+		{banking.CatchUpPolicy_CATCH_UP_POLICY_UNSPECIFIED, 1, []int64{40}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.policy.String(), func(t *testing.T) {
+			s := getNewTestServer()
+			accounts := createTestAccounts(t, s, 100, 0)
+			start := time.Now().Add(time.Minute)
+			res, err := s.ScheduleTransfer(context.Background(), &banking.ScheduleTransferRequest{
+				FromAccountId: accounts[0],
+				ToAccountId:   accounts[1],
+				Amount:        usd(10),
+				StartAt:       timestamppb.New(start),
+				Recurrence:    "FREQ=DAILY",
+				CatchUpPolicy: tt.policy,
+			})
+			require.NoError(t, err)
+
+			// The server comes back up three and a half days later, having
+			// missed four runs.
+			restart := start.Add(84 * time.Hour)
+			s.runDueSchedules(context.Background(), restart, restart)
+
+			schedule := scheduleDetails(t, s, res.Schedule.ScheduleId)
+			assert.Equal(t, tt.runs, schedule.RunCount)
+			assert.True(t, start.Add(96*time.Hour).Equal(schedule.NextRunAt.AsTime()))
+			txs, err := s.ListTransactions(context.Background(), &banking.ListTransactionsRequest{AccountId: accounts[1]})
+			require.NoError(t, err)
+			var amounts []int64
+			total := int64(0)
+			for _, tx := range txs.Transactions {
+				amounts = append(amounts, tx.Amount.Units)
+				total += tx.Amount.Units
+			}
+			assert.Equal(t, tt.amounts, amounts)
+			ledger, _ := balances(t, s, accounts[1])
+			assertProtoEqual(t, usd(total), ledger)
+		})
+	}
+}
+
+// visitingStore records the schedules the scheduler looks at.
+type visitingStore struct {
+	*MemoryStore
+	visited []string
+}
+
+func (v *visitingStore) ScanSchedules(ctx context.Context, order Ordering[*banking.Schedule], after *Cursor, fn func(schedule *banking.Schedule) bool) error {
+	return v.MemoryStore.ScanSchedules(ctx, order, after, func(schedule *banking.Schedule) bool {
+		v.visited = append(v.visited, schedule.ScheduleId)
+		return fn(schedule)
+	})
+}
+
+func TestRunDueSchedules_VisitsOnlyActiveSchedules(t *testing.T) {
+	store := &visitingStore{MemoryStore: NewMemoryStore()}
+	s := NewServer(store)
+	s.TestMode(false)
+	accounts := createTestAccounts(t, s, 100, 0)
+	start := time.Now()
+	schedule := func(at time.Time, recurrence string) string {
+		res, err := s.ScheduleTransfer(context.Background(), &banking.ScheduleTransferRequest{
+			FromAccountId: accounts[0],
+			ToAccountId:   accounts[1],
+			Amount:        usd(1),
+			StartAt:       timestamppb.New(at),
+			Recurrence:    recurrence,
+		})
+		require.NoError(t, err)
+		return res.Schedule.ScheduleId
+	}
+	completed := schedule(start, "")
+	cancelled := schedule(start, "FREQ=DAILY")
+	due := schedule(start.Add(time.Minute), "FREQ=DAILY")
+	later := schedule(start.Add(time.Hour), "FREQ=DAILY")
+	s.runDueSchedules(context.Background(), start, start)
+	require.Equal(t, banking.ScheduleStatus_SCHEDULE_STATUS_COMPLETED, scheduleDetails(t, s, completed).Status)
+	_, err := s.CancelSchedule(context.Background(), &banking.CancelScheduleRequest{ScheduleId: cancelled})
+	require.NoError(t, err)
+
+	store.visited = nil
+	s.runDueSchedules(context.Background(), start, start.Add(time.Minute))
+	assert.Equal(t, []string{due, later}, store.visited, "stops at the first schedule not yet due")
+	assert.Equal(t, int64(1), scheduleDetails(t, s, due).RunCount)
+	assert.Zero(t, scheduleDetails(t, s, later).RunCount)
+}
+
+func TestCancelSchedule(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0)
+	start := time.Now()
+	res, err := s.ScheduleTransfer(context.Background(), &banking.ScheduleTransferRequest{
+		FromAccountId: accounts[0],
+		ToAccountId:   accounts[1],
+		Amount:        usd(10),
+		Recurrence:    "0 9 * * *",
+	})
+	require.NoError(t, err)
+	id := res.Schedule.ScheduleId
+
+	cancelled, err := s.CancelSchedule(context.Background(), &banking.CancelScheduleRequest{ScheduleId: id, Reason: "moved out"})
+	require.NoError(t, err)
+	assert.Equal(t, banking.ScheduleStatus_SCHEDULE_STATUS_CANCELLED, cancelled.Schedule.Status)
+	assert.Equal(t, "moved out", cancelled.Schedule.CancelReason)
+
+	s.runDueSchedules(context.Background(), start, start.Add(48*time.Hour))
+	assert.Zero(t, scheduleDetails(t, s, id).RunCount)
+
+	_, err = s.CancelSchedule(context.Background(), &banking.CancelScheduleRequest{ScheduleId: id})
+	assert.Equal(t, ReasonScheduleNotActive, errorDetail[*errdetails.ErrorInfo](t, err).Reason)
+}
+
+func TestListSchedules(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0, 0)
+	var ids []string
+	for _, to := range []string{accounts[1], accounts[2], accounts[1]} {
+		res, err := s.ScheduleTransfer(context.Background(), &banking.ScheduleTransferRequest{
+			FromAccountId: accounts[0],
+			ToAccountId:   to,
+			Amount:        usd(10),
+			Recurrence:    "@monthly",
+		})
+		require.NoError(t, err)
+		ids = append(ids, res.Schedule.ScheduleId)
+	}
+	_, err := s.CancelSchedule(context.Background(), &banking.CancelScheduleRequest{ScheduleId: ids[2]})
+	require.NoError(t, err)
+
+	scheduleIDs := func(req *banking.ListSchedulesRequest) []string {
+		res, err := s.ListSchedules(context.Background(), req)
+		require.NoError(t, err)
+		var ids []string
+		for _, schedule := range res.Schedules {
+			ids = append(ids, schedule.ScheduleId)
+		}
+		return ids
+	}
+	assert.Equal(t, ids, scheduleIDs(&banking.ListSchedulesRequest{}))
+	assert.Equal(t, []string{ids[0], ids[2]}, scheduleIDs(&banking.ListSchedulesRequest{AccountId: accounts[1]}))
+	assert.Equal(t, ids[:2], scheduleIDs(&banking.ListSchedulesRequest{Status: banking.ScheduleStatus_SCHEDULE_STATUS_ACTIVE}))
+
+	page, err := s.ListSchedules(context.Background(), &banking.ListSchedulesRequest{PageSize: 2})
+	require.NoError(t, err)
+	assert.Len(t, page.Schedules, 2)
+	assert.Equal(t, ids[2:], scheduleIDs(&banking.ListSchedulesRequest{PageSize: 2, PageToken: page.NextPageToken}))
+}
+
+func TestScheduleTransfer_Invalid(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0)
+
+	tests := []struct {
+		req   *banking.ScheduleTransferRequest
+		field string
+	}{
+		{&banking.ScheduleTransferRequest{}, "startAt"},
+		{&banking.ScheduleTransferRequest{Recurrence: "every day"}, "recurrence"},
+		{&banking.ScheduleTransferRequest{Recurrence: "FREQ=DAILY;COUNT=1", StartAt: timestamppb.New(time.Now().Add(-time.Hour))}, "recurrence"},
+		{&banking.ScheduleTransferRequest{StartAt: timestamppb.Now(), EndAt: timestamppb.Now()}, "endAt"},
+	}
+	for _, tt := range tests {
+		tt.req.FromAccountId = accounts[0]
+		tt.req.ToAccountId = accounts[1]
+		tt.req.Amount = usd(10)
+		_, err := s.ScheduleTransfer(context.Background(), tt.req)
+		assert.Equal(t, tt.field, errorDetail[*errdetails.BadRequest](t, err).FieldViolations[0].Field)
+	}
+}
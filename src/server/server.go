@@ -4,189 +4,582 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
-	"strconv"
+	"net/http"
 	"sync"
 	"time"
 
 	"github.com/bryanvaz/grpc-gl/protos/go/banking"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var ServerIsRunningError = errors.New("Server is running.")
 
-var accounts = make(map[string]int32)
-var transactions = make(map[string]*banking.Transaction)
-var mtx sync.Mutex
-
-var DEBUG = true
+// maxIDAttempts bounds how many fresh IDs are tried when a generated ID
+// collides with an existing one.
+const maxIDAttempts = 3
 
 type Server struct {
 	banking.UnimplementedBankingServiceServer
-	Port       int
-	running    bool
-	grpcServer *grpc.Server
+	// Address is the host:port the server listens on.
+	Address string
+	// MetricsAddress is the host:port Prometheus metrics are served on, at
+	// /metrics. They are not served while it is empty.
+	MetricsAddress string
+	// Credentials secures connections. The server accepts plaintext
+	// connections while it is nil.
+	Credentials credentials.TransportCredentials
+	// Reflection registers the gRPC reflection service.
+	Reflection bool
+	// Logger receives the server's logs, including one record per call.
+	// NewServer sets it to slog.Default().
+	Logger *slog.Logger
+	// Authenticator resolves the bearer token every call but Ping must
+	// carry, and turns on the authorisation policy. While it is nil any
+	// caller may do anything.
+	Authenticator Authenticator
+	// MaxRecvMsgSize is the largest message the server accepts, in bytes.
+	// Zero keeps the gRPC default.
+	MaxRecvMsgSize int
+	// MaxConcurrentStreams limits the streams each client connection may
+	// have open at once. Zero leaves it unlimited.
+	MaxConcurrentStreams uint32
+	// Rates converts amounts between currencies. Transfers between accounts
+	// in different currencies are rejected while it is nil.
+	Rates RateSource
+	// IdempotencyTTL is how long the result of a request carrying an
+	// idempotency key is replayed to repeats of that request.
+	IdempotencyTTL time.Duration
+	// TransactionIDs generates the ID of every new transaction.
+	TransactionIDs IDGenerator
+	// BatchSize is the most transfers a BatchTransfer stream commits at once.
+	BatchSize int
+	// BatchWindow is how long a BatchTransfer stream waits for more
+	// transfers before committing a batch that is not yet full.
+	BatchWindow time.Duration
+	// HoldTTL is how long an AuthorizeTransfer hold lasts when the request
+	// does not say.
+	HoldTTL time.Duration
+	// HoldExpiryInterval is how often lapsed holds are released while the
+	// server is running. Zero disables the sweep.
+	HoldExpiryInterval time.Duration
+	// ScheduleInterval is how often due scheduled transfers are made while
+	// the server is running. Zero disables them.
+	ScheduleInterval time.Duration
+	// ScheduleCatchUp is the catch-up policy of schedules that do not set
+	// their own.
+	ScheduleCatchUp banking.CatchUpPolicy
+	mtx             sync.Mutex // guards the fields Serve sets up and GracefulStop tears down
+	running         bool
+	grpcServer      *grpc.Server
+	metricsServer   *http.Server
+	metrics         *metrics
+	store           Store
+	idempotency     *idempotencyCache
+	events          *eventHub
+	done            chan struct{}
+	wg              sync.WaitGroup
 }
 
-func NewServer() *Server {
-	return &Server{
-		Port: 50051,
+// NewServer returns a Server backed by store. Servers never share state
+// unless they are given the same Store.
+func NewServer(store Store) *Server {
+	s := &Server{
+		Address:            ":50051",
+		Reflection:         true,
+		Logger:             slog.Default(),
+		IdempotencyTTL:     24 * time.Hour,
+		TransactionIDs:     UUIDv7Generator{},
+		BatchSize:          100,
+		BatchWindow:        2 * time.Millisecond,
+		HoldTTL:            7 * 24 * time.Hour,
+		HoldExpiryInterval: time.Minute,
+		ScheduleInterval:   time.Second,
+		ScheduleCatchUp:    banking.CatchUpPolicy_CATCH_UP_POLICY_RUN_ONCE,
 	}
+	s.metrics = newMetrics()
+	s.setStore(store)
+	return s
+}
+
+// setStore makes store the server's store, publishing its commits to
+// WatchAccount streams.
+func (s *Server) setStore(store Store) {
+	s.store = store
+	s.idempotency = newIdempotencyCache(store, s.logger)
+	s.events = newEventHub()
+	store.OnCommit(s.events.publish)
+	store.OnAccountStatus(s.metrics.accountStatusChanged)
 }
 
 func (s *Server) IsRunning() bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
 	return s.running
 }
 
 func (s *Server) TestMode(truncate bool) {
-	DEBUG = false
+	s.Logger = discardLogger()
 	if truncate {
-		accounts = make(map[string]int32)
-		transactions = make(map[string]*banking.Transaction)
+		s.setStore(NewMemoryStore())
 	}
 }
 
 func (s *Server) Start() error {
-	listener, err := net.Listen("tcp", ":"+strconv.Itoa(s.Port))
+	listener, err := net.Listen("tcp", s.Address)
 	if err != nil {
-		log.Fatalf("Failed to listen: %v", err)
 		return err
 	}
-	grpcServer := grpc.NewServer()
-	banking.RegisterBankingServiceServer(grpcServer, s)
-	reflection.Register(grpcServer)
-	s.grpcServer = grpcServer
-	s.running = true
+	return s.Serve(listener)
+}
+
+// Serve serves connections accepted by listener until the server is
+// stopped. Start listens on Address and calls it. IsRunning reports true
+// once the server is ready to handle the connections listener accepts.
+func (s *Server) Serve(listener net.Listener) error {
+	grpcServer, err := s.setUp()
+	if err != nil {
+		listener.Close()
+		return err
+	}
 	// Start serving incoming connections
 	err = grpcServer.Serve(listener)
-	s.running = false
+	s.mtx.Lock()
+	if s.grpcServer == grpcServer {
+		s.running = false
+	}
+	s.mtx.Unlock()
 	return err
 }
 
+// setUp opens the store and starts everything Serve needs but the gRPC
+// server itself, which it returns.
+func (s *Server) setUp() (*grpc.Server, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.grpcServer != nil {
+		return nil, ServerIsRunningError
+	}
+	if err := s.store.Open(); err != nil {
+		return nil, err
+	}
+	if err := s.metrics.countAccounts(context.Background(), s.store); err != nil {
+		s.store.Close()
+		return nil, err
+	}
+	if s.MetricsAddress != "" {
+		if err := s.serveMetrics(); err != nil {
+			s.store.Close()
+			return nil, err
+		}
+	}
+	opts := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			s.loggingUnaryInterceptor,
+			s.metricsUnaryInterceptor,
+			s.authenticationUnaryInterceptor,
+			validationUnaryInterceptor,
+			s.authorizationUnaryInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			s.loggingStreamInterceptor,
+			s.metricsStreamInterceptor,
+			s.authenticationStreamInterceptor,
+			validationStreamInterceptor,
+			s.authorizationStreamInterceptor,
+		),
+	}
+	if s.Credentials != nil {
+		opts = append(opts, grpc.Creds(s.Credentials))
+	}
+	if s.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(s.MaxRecvMsgSize))
+	}
+	if s.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(s.MaxConcurrentStreams))
+	}
+	grpcServer := grpc.NewServer(opts...)
+	banking.RegisterBankingServiceServer(grpcServer, s)
+	if s.Reflection {
+		reflection.Register(grpcServer)
+	}
+	s.grpcServer = grpcServer
+	s.running = true
+	s.done = make(chan struct{})
+	if s.HoldExpiryInterval > 0 {
+		s.wg.Add(1)
+		go s.expireHolds(s.done)
+	}
+	if s.ScheduleInterval > 0 {
+		s.wg.Add(1)
+		go s.runSchedules(s.done, time.Now())
+	}
+	return grpcServer, nil
+}
+
+// GracefulStop waits for the calls in progress to finish, stops the
+// server and closes its store. It does nothing if the server was never set
+// up by Serve.
 func (s *Server) GracefulStop() {
-	if s.running {
-		// Gracefully stop the server
-		s.grpcServer.GracefulStop()
-		s.running = false
+	s.mtx.Lock()
+	grpcServer, metricsServer, done := s.grpcServer, s.metricsServer, s.done
+	s.grpcServer, s.metricsServer, s.done = nil, nil, nil
+	s.running = false
+	s.mtx.Unlock()
+	if grpcServer == nil {
+		return
+	}
+
+	// Gracefully stop the server. This waits for Serve to return, so it
+	// must not hold mtx.
+	grpcServer.GracefulStop()
+	if metricsServer != nil {
+		metricsServer.Close()
+	}
+	close(done)
+	s.wg.Wait()
+	if err := s.store.Close(); err != nil {
+		s.Logger.Error("Failed to close store", "error", err)
 	}
 }
 
 func (s *Server) Ping(ctx context.Context, req *banking.PingRequest) (*banking.PingResponse, error) {
-	mtx.Lock()
-	defer mtx.Unlock()
-
 	return &banking.PingResponse{Message: "Pong"}, nil
 }
 
 func (s *Server) MakeTransaction(ctx context.Context, req *banking.TransactionRequest) (*banking.TransactionResponse, error) {
-	mtx.Lock()
-	defer mtx.Unlock()
+	return idempotent(s.idempotency, ctx, s.IdempotencyTTL, "MakeTransaction", req.IdempotencyKey, req,
+		func() (*banking.TransactionResponse, error) { return s.makeTransaction(ctx, req) })
+}
 
-	fromBalance, ok1 := accounts[req.FromAccountId]
-	toBalance, ok2 := accounts[req.ToAccountId]
+func (s *Server) makeTransaction(ctx context.Context, req *banking.TransactionRequest) (*banking.TransactionResponse, error) {
+	transaction, err := s.newTransaction(ctx, req)
+	if err != nil {
+		return nil, err
+	}
 
-	if !ok1 || !ok2 {
-		return &banking.TransactionResponse{Success: false, Message: "Account not found"}, nil
+	if err := s.transfer(ctx, transaction); err != nil {
+		s.recordFailure(ctx, transaction, err)
+		return nil, statusError(err)
 	}
 
-	// if fromBalance < req.Amount {
-	// 	return &banking.TransactionResponse{Success: false, Message: "Insufficient balance"}, nil
-	// }
+	return s.transactionPosted(ctx, transaction), nil
+}
 
-	transactionID := fmt.Sprintf("%d", time.Now().UnixNano())
+// recordFailure counts the failure of tx and records tx as failed if err
+// declined it, so that declined transfers can be reconciled as well as
+// posted ones.
+func (s *Server) recordFailure(ctx context.Context, tx *banking.Transaction, err error) {
+	s.metrics.transferFailed(err)
+	var insufficient *InsufficientFundsError
+	if !errors.As(err, &insufficient) && !errors.Is(err, AccountFrozenError) && !errors.Is(err, AccountClosedError) {
+		return
+	}
+	tx.Status = banking.TransactionStatus_TRANSACTION_STATUS_FAILED
+	tx.FailureReason = err.Error()
+	if err := s.store.RecordFailedTransfer(ctx, tx); err != nil {
+		s.logger(ctx).ErrorContext(ctx, "Failed to record failed transaction", "transactionId", tx.TransactionId, "error", err)
+	}
+}
 
-	accounts[req.FromAccountId] -= req.Amount
-	accounts[req.ToAccountId] += req.Amount
+// newTransaction builds the transaction requested by req, converting the
+// amount if the accounts hold different currencies.
+func (s *Server) newTransaction(ctx context.Context, req *banking.TransactionRequest) (*banking.Transaction, error) {
+	if err := validateMoney(req.Amount); err != nil {
+		return nil, invalidArgument(FieldViolation{"amount", err.Error()})
+	}
 
 	transaction := &banking.Transaction{
-		TransactionId: transactionID,
 		FromAccountId: req.FromAccountId,
 		ToAccountId:   req.ToAccountId,
 		Amount:        req.Amount,
+		CreatedAt:     timestamppb.Now(),
+		Status:        banking.TransactionStatus_TRANSACTION_STATUS_POSTED,
+		Memo:          req.Memo,
+		Reference:     req.Reference,
 	}
 
-	transactions[transactionID] = transaction
+	if err := s.convert(ctx, transaction); err != nil {
+		return nil, statusError(err)
+	}
+	return transaction, nil
+}
 
-	if DEBUG {
-		log.Printf(
-			"MakeTransaction: Old balances: From: %d (%s), To: %d (%s)\n",
-			fromBalance, req.FromAccountId, toBalance, req.ToAccountId,
-		)
-		log.Printf(
-			"MakeTransaction: ID: %s, From: %s, To: %s, Amount: %d\n",
-			transactionID, req.FromAccountId, req.ToAccountId, req.Amount,
-		)
-		log.Printf(
-			"MakeTransaction: New balances: From: %d (%s), To: %d (%s)\n",
-			accounts[req.FromAccountId], req.FromAccountId, accounts[req.ToAccountId], req.ToAccountId,
-		)
+// transactionPosted logs tx and builds the response reporting it.
+func (s *Server) transactionPosted(ctx context.Context, tx *banking.Transaction) *banking.TransactionResponse {
+	s.metrics.transactionPosted(tx)
+	s.logger(ctx).DebugContext(ctx, "Posted transaction",
+		"transactionId", tx.TransactionId,
+		"fromAccountId", tx.FromAccountId,
+		"toAccountId", tx.ToAccountId,
+		"amount", formatMoney(tx.Amount),
+		"status", tx.Status.String(),
+	)
+
+	return &banking.TransactionResponse{
+		TransactionId: tx.TransactionId,
+		Success:       true,
+		Message:       "Transaction Successful",
+		IdFormat:      tx.IdFormat,
 	}
+}
 
-	return &banking.TransactionResponse{TransactionId: transactionID, Success: true, Message: "Transaction Successful"}, nil
+// transfer assigns tx a fresh ID and posts it.
+func (s *Server) transfer(ctx context.Context, tx *banking.Transaction) error {
+	return s.post(ctx, tx, s.store.Transfer)
 }
 
-func (s *Server) GetBalance(ctx context.Context, req *banking.BalanceRequest) (*banking.BalanceResponse, error) {
-	mtx.Lock()
-	defer mtx.Unlock()
+// post assigns tx a fresh ID and hands it to commit, retrying on
+// collisions.
+func (s *Server) post(ctx context.Context, tx *banking.Transaction, commit func(ctx context.Context, tx *banking.Transaction) error) error {
+	return s.withFreshID(TransactionExistsError, func(id string, format banking.IdFormat) error {
+		tx.TransactionId, tx.IdFormat = id, format
+		return commit(ctx, tx)
+	})
+}
 
-	balance, ok := accounts[req.AccountId]
-	if !ok {
-		return nil, fmt.Errorf("Account not found")
+// withFreshID calls commit with a fresh ID and its format, trying another
+// ID while commit fails with an error wrapping exists. The store rejects
+// IDs that are already taken, so a collision costs a retry rather than
+// overwriting an earlier record.
+func (s *Server) withFreshID(exists error, commit func(id string, format banking.IdFormat) error) error {
+	var id string
+	var err error
+	for attempt := 0; attempt < maxIDAttempts; attempt++ {
+		if id, err = s.TransactionIDs.NewID(); err != nil {
+			return err
+		}
+		err = commit(id, s.TransactionIDs.Format())
+		if !errors.Is(err, exists) {
+			return err
+		}
 	}
+	return err
+}
 
-	if DEBUG {
-		log.Println("GetBalance: ID:", req.AccountId, "Balance:", balance)
+// convert fills in tx.CreditAmount and tx.ExchangeRate when the receiver
+// holds a different currency to the sender.
+func (s *Server) convert(ctx context.Context, tx *banking.Transaction) error {
+	from, err := s.store.GetAccount(ctx, tx.FromAccountId)
+	if err != nil {
+		return err
+	}
+	to, err := s.store.GetAccount(ctx, tx.ToAccountId)
+	if err != nil {
+		return err
+	}
+	if tx.Amount.CurrencyCode != from.Balance.CurrencyCode {
+		return fmt.Errorf("%w: account %s holds %s", CurrencyMismatchError, from.Id, from.Balance.CurrencyCode)
+	}
+	if to.Balance.CurrencyCode == from.Balance.CurrencyCode {
+		return nil
+	}
+	if s.Rates == nil {
+		return CrossCurrencyDisabledError
 	}
 
-	return &banking.BalanceResponse{Balance: balance}, nil
+	rate, err := s.Rates.Rate(from.Balance.CurrencyCode, to.Balance.CurrencyCode)
+	if err != nil {
+		if errors.Is(err, ExchangeRateUnavailableError) {
+			return err
+		}
+		return fmt.Errorf("%w: %v", ExchangeRateUnavailableError, err)
+	}
+	credit, err := convertMoney(tx.Amount, to.Balance.CurrencyCode, rate)
+	if err != nil {
+		return err
+	}
+	tx.CreditAmount = credit
+	tx.ExchangeRate = rate.FloatString(9)
+	return nil
+}
+
+func (s *Server) GetBalance(ctx context.Context, req *banking.BalanceRequest) (*banking.BalanceResponse, error) {
+	account, err := s.store.GetAccount(ctx, req.AccountId)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	available, err := availableBalance(account)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	s.logger(ctx).DebugContext(ctx, "Read balance",
+		"accountId", req.AccountId, "balance", formatMoney(account.Balance), "availableBalance", formatMoney(available))
+
+	return &banking.BalanceResponse{Balance: account.Balance, AvailableBalance: available}, nil
 }
 
 func (s *Server) CreateAccount(ctx context.Context, req *banking.AccountRequest) (*banking.AccountResponse, error) {
-	mtx.Lock()
-	defer mtx.Unlock()
+	return idempotent(s.idempotency, ctx, s.IdempotencyTTL, "CreateAccount", req.IdempotencyKey, req,
+		func() (*banking.AccountResponse, error) { return s.createAccount(ctx, req) })
+}
 
-	accountID := uuid.New().String()
-	accounts[accountID] = req.InitialBalance
+func (s *Server) createAccount(ctx context.Context, req *banking.AccountRequest) (*banking.AccountResponse, error) {
+	var violations []FieldViolation
+	if err := validateMoney(req.InitialBalance); err != nil {
+		violations = append(violations, FieldViolation{"initialBalance", err.Error()})
+	}
+	if req.OverdraftLimit != nil {
+		switch err := validateMoney(req.OverdraftLimit); {
+		case req.OverdraftPolicy != banking.OverdraftPolicy_OVERDRAFT_POLICY_LIMIT:
+			violations = append(violations, FieldViolation{"overdraftLimit", "requires OVERDRAFT_POLICY_LIMIT"})
+		case err != nil:
+			violations = append(violations, FieldViolation{"overdraftLimit", err.Error()})
+		case req.OverdraftLimit.CurrencyCode != req.InitialBalance.GetCurrencyCode():
+			violations = append(violations, FieldViolation{"overdraftLimit", "must be in the account's currency"})
+		case moneyNanos(req.OverdraftLimit).Sign() < 0:
+			violations = append(violations, FieldViolation{"overdraftLimit", "must not be negative"})
+		}
+	}
+	violations = append(violations, checkLabels("labels", req.Labels)...)
+	if len(violations) > 0 {
+		return nil, invalidArgument(violations...)
+	}
 
-	if DEBUG {
-		log.Println("CreateAccount: ID:", accountID, "Balance:", req.InitialBalance)
+	accountID := uuid.New().String()
+	now := timestamppb.Now()
+	account := &banking.Account{
+		Id:              accountID,
+		Balance:         req.InitialBalance,
+		OverdraftPolicy: req.OverdraftPolicy,
+		OverdraftLimit:  req.OverdraftLimit,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		Status:          banking.AccountStatus_ACCOUNT_STATUS_ACTIVE,
+		OwnerId:         req.OwnerId,
+		DisplayName:     req.DisplayName,
+		Type:            req.Type,
+		Labels:          req.Labels,
+	}
+	if err := s.store.CreateAccount(ctx, account); err != nil {
+		return nil, statusError(err)
 	}
 
+	s.logger(ctx).DebugContext(ctx, "Created account", "accountId", accountID, "initialBalance", formatMoney(req.InitialBalance))
+
 	return &banking.AccountResponse{AccountId: accountID}, nil
 }
 
-func (s *Server) GetTransactionDetails(ctx context.Context, req *banking.TransactionDetailsRequest) (*banking.TransactionDetailsResponse, error) {
-	mtx.Lock()
-	defer mtx.Unlock()
+func (s *Server) UpdateAccount(ctx context.Context, req *banking.UpdateAccountRequest) (*banking.UpdateAccountResponse, error) {
+	var violations []FieldViolation
+	if len(req.UpdateMask.GetPaths()) == 0 {
+		violations = append(violations, FieldViolation{"updateMask", "is required"})
+	}
+	for _, path := range req.UpdateMask.GetPaths() {
+		switch path {
+		case "ownerId", "displayName", "type":
+		case "labels":
+			violations = append(violations, checkLabels("account.labels", req.Account.GetLabels())...)
+		default:
+			violations = append(violations, FieldViolation{"updateMask", path + " cannot be updated"})
+		}
+	}
+	if len(violations) > 0 {
+		return nil, invalidArgument(violations...)
+	}
 
-	transaction, ok := transactions[req.TransactionId]
-	if !ok {
-		return nil, fmt.Errorf("Transaction not found")
+	account, err := s.store.UpdateAccount(ctx, req.Account.GetId(), func(account *banking.Account) error {
+		for _, path := range req.UpdateMask.Paths {
+			switch path {
+			case "ownerId":
+				account.OwnerId = req.Account.OwnerId
+			case "displayName":
+				account.DisplayName = req.Account.DisplayName
+			case "type":
+				account.Type = req.Account.Type
+			case "labels":
+				account.Labels = req.Account.Labels
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, statusError(err)
 	}
 
-	if DEBUG {
-		log.Println("GetTransactionDetails: ID:", req.TransactionId, "Transaction:", transaction)
+	s.logger(ctx).DebugContext(ctx, "Updated account", "accountId", account.Id, "fields", req.UpdateMask.Paths)
+
+	return &banking.UpdateAccountResponse{Account: account}, nil
+}
+
+func (s *Server) GetTransactionDetails(ctx context.Context, req *banking.TransactionDetailsRequest) (*banking.TransactionDetailsResponse, error) {
+	transaction, err := s.store.GetTransaction(ctx, req.TransactionId)
+	if err != nil {
+		return nil, statusError(err)
 	}
 
+	s.logger(ctx).DebugContext(ctx, "Read transaction",
+		"transactionId", req.TransactionId,
+		"fromAccountId", transaction.FromAccountId,
+		"toAccountId", transaction.ToAccountId,
+		"amount", formatMoney(transaction.Amount),
+	)
+
 	return &banking.TransactionDetailsResponse{Transaction: transaction}, nil
 }
 
 func (s *Server) ListAccount(ctx context.Context, req *banking.ListAccountRequest) (*banking.ListAccountResponse, error) {
-	mtx.Lock()
-	defer mtx.Unlock()
+	res, err := listAccounts(ctx, s.store, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger(ctx).DebugContext(ctx, "Listed accounts", "ownerId", req.OwnerId, "accounts", len(res.Accounts))
 
-	var accountList []*banking.Account
+	return res, nil
+}
 
-	for id, balance := range accounts {
-		accountList = append(accountList, &banking.Account{Id: id, Balance: balance})
+func (s *Server) ListTransactions(ctx context.Context, req *banking.ListTransactionsRequest) (*banking.ListTransactionsResponse, error) {
+	res, err := listTransactions(ctx, s.store, req)
+	if err != nil {
+		return nil, err
 	}
 
-	if DEBUG {
-		log.Println("ListAccount: Accounts:", accountList)
+	s.logger(ctx).DebugContext(ctx, "Listed transactions", "accountId", req.AccountId, "transactions", len(res.Transactions))
+
+	return res, nil
+}
+
+func (s *Server) WatchAccount(req *banking.WatchAccountRequest, stream banking.BankingService_WatchAccountServer) error {
+	ctx := stream.Context()
+	for _, id := range req.AccountIds {
+		if _, err := s.store.GetAccount(ctx, id); err != nil {
+			return statusError(err)
+		}
 	}
 
-	return &banking.ListAccountResponse{Accounts: accountList}, nil
+	sub, backlog, err := s.events.subscribe(req.AccountIds, req.AfterSequence)
+	if err != nil {
+		return statusError(err)
+	}
+	defer s.events.unsubscribe(sub)
+
+	s.logger(ctx).DebugContext(ctx, "Watching accounts", "accountIds", req.AccountIds, "afterSequence", req.AfterSequence)
+
+	for _, event := range backlog {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case event := <-sub.events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		case <-sub.lagged:
+			return statusError(WatchLaggedError)
+		case <-stream.Context().Done():
+			return statusError(stream.Context().Err())
+		}
+	}
 }
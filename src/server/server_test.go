@@ -2,14 +2,30 @@ package server
 
 import (
 	"context"
+	"math/big"
+	"sort"
 	"testing"
 
 	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
 )
 
+func usd(units int64) *banking.Money {
+	return &banking.Money{CurrencyCode: "USD", Units: units}
+}
+
+func assertProtoEqual(t *testing.T, expected, actual any) bool {
+	t.Helper()
+	return assert.Empty(t, cmp.Diff(expected, actual, protocmp.Transform()))
+}
+
 func getNewTestServer() *Server {
-	s := NewServer()
+	s := NewServer(NewMemoryStore())
 	s.TestMode(true)
 	return s
 }
@@ -27,13 +43,13 @@ func TestServer_Ping(t *testing.T) {
 
 func TestServer_MakeTransaction(t *testing.T) {
 	s := getNewTestServer()
-	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: 100})
-	ca2, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: 100})
+	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+	ca2, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
 
 	req := &banking.TransactionRequest{
 		FromAccountId: ca1.AccountId,
 		ToAccountId:   ca2.AccountId,
-		Amount:        50,
+		Amount:        usd(50),
 	}
 	expected := &banking.TransactionResponse{
 		TransactionId: ".*",
@@ -47,24 +63,91 @@ func TestServer_MakeTransaction(t *testing.T) {
 	assert.Regexp(t, expected.TransactionId, res.TransactionId)
 	assert.Equal(t, expected.Success, res.Success)
 	assert.Equal(t, expected.Message, res.Message)
+	assert.Equal(t, banking.IdFormat_ID_FORMAT_UUID_V7, res.IdFormat)
+}
+
+func TestServer_MakeTransaction_InsufficientFunds(t *testing.T) {
+	s := getNewTestServer()
+	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+	ca2, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+
+	_, err := s.MakeTransaction(context.Background(), &banking.TransactionRequest{
+		FromAccountId: ca1.AccountId,
+		ToAccountId:   ca2.AccountId,
+		Amount:        usd(150),
+	})
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Len(t, st.Details(), 2)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	assert.True(t, ok)
+	assert.Equal(t, "INSUFFICIENT_FUNDS", info.Reason)
+	assert.Equal(t, "50", info.Metadata["shortfall"])
+
+	balance, _ := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: ca1.AccountId})
+	assert.Equal(t, int64(100), balance.Balance.Units)
+}
+
+func TestServer_MakeTransaction_OverdraftLimit(t *testing.T) {
+	s := getNewTestServer()
+	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{
+		InitialBalance:  usd(100),
+		OverdraftPolicy: banking.OverdraftPolicy_OVERDRAFT_POLICY_LIMIT,
+		OverdraftLimit:  usd(50),
+	})
+	ca2, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+	req := &banking.TransactionRequest{FromAccountId: ca1.AccountId, ToAccountId: ca2.AccountId, Amount: usd(150)}
+
+	res, err := s.MakeTransaction(context.Background(), req)
+	assert.NoError(t, err)
+	assert.True(t, res.Success)
+
+	_, err = s.MakeTransaction(context.Background(), &banking.TransactionRequest{
+		FromAccountId: ca1.AccountId,
+		ToAccountId:   ca2.AccountId,
+		Amount:        usd(1),
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestServer_CreateAccount_InvalidOverdraft(t *testing.T) {
+	s := getNewTestServer()
+
+	_, err := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100), OverdraftLimit: usd(50)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.CreateAccount(context.Background(), &banking.AccountRequest{
+		InitialBalance:  usd(100),
+		OverdraftPolicy: banking.OverdraftPolicy_OVERDRAFT_POLICY_LIMIT,
+		OverdraftLimit:  usd(-1),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.CreateAccount(context.Background(), &banking.AccountRequest{
+		InitialBalance:  usd(100),
+		OverdraftPolicy: banking.OverdraftPolicy_OVERDRAFT_POLICY_LIMIT,
+		OverdraftLimit:  &banking.Money{CurrencyCode: "EUR", Units: 50},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 
 func TestServer_GetBalance(t *testing.T) {
 	s := getNewTestServer()
-	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: 100})
+	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
 
 	req := &banking.BalanceRequest{AccountId: ca1.AccountId}
-	expected := &banking.BalanceResponse{Balance: 100}
+	expected := &banking.BalanceResponse{Balance: usd(100), AvailableBalance: usd(100)}
 
 	res, err := s.GetBalance(context.Background(), req)
 
 	assert.NoError(t, err)
-	assert.Equal(t, expected, res)
+	assertProtoEqual(t, expected, res)
 }
 
 func TestServer_CreateAccount(t *testing.T) {
 	s := getNewTestServer()
-	req := &banking.AccountRequest{InitialBalance: 100}
+	req := &banking.AccountRequest{InitialBalance: usd(100)}
 	expected := &banking.AccountResponse{AccountId: ".*"}
 
 	res, err := s.CreateAccount(context.Background(), req)
@@ -75,12 +158,12 @@ func TestServer_CreateAccount(t *testing.T) {
 
 func TestServer_GetTransactionDetails(t *testing.T) {
 	s := getNewTestServer()
-	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: 100})
-	ca2, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: 100})
+	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+	ca2, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
 	tx1, _ := s.MakeTransaction(context.Background(), &banking.TransactionRequest{
 		FromAccountId: ca1.AccountId,
 		ToAccountId:   ca2.AccountId,
-		Amount:        50,
+		Amount:        usd(50),
 	})
 
 	req := &banking.TransactionDetailsRequest{TransactionId: tx1.TransactionId}
@@ -89,7 +172,7 @@ func TestServer_GetTransactionDetails(t *testing.T) {
 			TransactionId: tx1.TransactionId,
 			FromAccountId: ca1.AccountId,
 			ToAccountId:   ca2.AccountId,
-			Amount:        50,
+			Amount:        usd(50),
 		},
 	}
 
@@ -99,25 +182,66 @@ func TestServer_GetTransactionDetails(t *testing.T) {
 	assert.Regexp(t, expected.Transaction.TransactionId, res.Transaction.TransactionId)
 	assert.Equal(t, expected.Transaction.FromAccountId, res.Transaction.FromAccountId)
 	assert.Equal(t, expected.Transaction.ToAccountId, res.Transaction.ToAccountId)
-	assert.Equal(t, expected.Transaction.Amount, res.Transaction.Amount)
+	assertProtoEqual(t, expected.Transaction.Amount, res.Transaction.Amount)
 }
 
 func TestServer_ListAccount(t *testing.T) {
 	s := getNewTestServer()
-	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: 100})
-	ca2, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: 100})
-
-	req := &banking.ListAccountRequest{}
+	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+	ca2, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+
+	// Both accounts may be created within one clock tick, so list them in
+	// an order that does not depend on it.
+	req := &banking.ListAccountRequest{OrderBy: "id"}
+	ids := []string{ca1.AccountId, ca2.AccountId}
+	sort.Strings(ids)
 	expected := &banking.ListAccountResponse{
 		Accounts: []*banking.Account{
-			{Id: ca1.AccountId, Balance: 100},
-			{Id: ca2.AccountId, Balance: 100},
+			{Id: ids[0], Balance: usd(100), Status: banking.AccountStatus_ACCOUNT_STATUS_ACTIVE},
+			{Id: ids[1], Balance: usd(100), Status: banking.AccountStatus_ACCOUNT_STATUS_ACTIVE},
 		},
+		TotalSize: 2,
 	}
 
 	res, err := s.ListAccount(context.Background(), req)
 
 	assert.NoError(t, err)
 	assert.Equal(t, len(expected.Accounts), len(res.Accounts))
-	assert.Equal(t, expected, res)
+	assert.Empty(t, cmp.Diff(expected, res, protocmp.Transform(),
+		protocmp.IgnoreFields(&banking.Account{}, "createdAt", "updatedAt")))
+}
+
+func TestServer_IsolatedStores(t *testing.T) {
+	s1 := getNewTestServer()
+	s2 := getNewTestServer()
+	ca1, _ := s1.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+
+	_, err := s2.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: ca1.AccountId})
+	assert.ErrorIs(t, err, AccountNotFoundError)
+
+	res, err := s2.ListAccount(context.Background(), &banking.ListAccountRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, res.Accounts)
+}
+
+func TestServer_MakeTransaction_CrossCurrency(t *testing.T) {
+	s := getNewTestServer()
+	ca1, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{InitialBalance: usd(100)})
+	ca2, _ := s.CreateAccount(context.Background(), &banking.AccountRequest{
+		InitialBalance: &banking.Money{CurrencyCode: "EUR", Units: 100},
+	})
+	req := &banking.TransactionRequest{FromAccountId: ca1.AccountId, ToAccountId: ca2.AccountId, Amount: usd(10)}
+
+	_, err := s.MakeTransaction(context.Background(), req)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	s.Rates = StaticRates{"USD/EUR": big.NewRat(9, 10)}
+	res, err := s.MakeTransaction(context.Background(), req)
+	assert.NoError(t, err)
+	assert.True(t, res.Success)
+
+	balance, _ := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: ca2.AccountId})
+	assertProtoEqual(t, &banking.Money{CurrencyCode: "EUR", Units: 109}, balance.Balance)
+	tx, _ := s.GetTransactionDetails(context.Background(), &banking.TransactionDetailsRequest{TransactionId: res.TransactionId})
+	assertProtoEqual(t, &banking.Money{CurrencyCode: "EUR", Units: 9}, tx.Transaction.CreditAmount)
 }
@@ -0,0 +1,198 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"google.golang.org/protobuf/proto"
+)
+
+// availableToDebit returns how many nanos can be debited from account
+// under its overdraft policy, or false if there is no limit. Funds held by
+// pending authorisations are not available.
+func availableToDebit(account *banking.Account) (*big.Int, bool) {
+	available := new(big.Int).Sub(moneyNanos(account.Balance), moneyNanos(account.HeldAmount))
+	switch account.OverdraftPolicy {
+	case banking.OverdraftPolicy_OVERDRAFT_POLICY_UNLIMITED:
+		return nil, false
+	case banking.OverdraftPolicy_OVERDRAFT_POLICY_LIMIT:
+		return available.Add(available, moneyNanos(account.OverdraftLimit)), true
+	default:
+		return available, true
+	}
+}
+
+// checkFunds returns an *InsufficientFundsError if debit nanos cannot be
+// taken from account.
+func checkFunds(account *banking.Account, debit *big.Int) error {
+	available, limited := availableToDebit(account)
+	if !limited || available.Cmp(debit) >= 0 {
+		return nil
+	}
+	shortfall, err := moneyFromNanos(account.Balance.CurrencyCode, new(big.Int).Sub(debit, available))
+	if err != nil {
+		return err
+	}
+	return &InsufficientFundsError{AccountID: account.Id, Shortfall: shortfall}
+}
+
+// checkCanSend returns a *ResourceError if account's status does not allow
+// it to be debited.
+func checkCanSend(account *banking.Account) error {
+	switch account.Status {
+	case banking.AccountStatus_ACCOUNT_STATUS_FROZEN:
+		return &ResourceError{Err: AccountFrozenError, ResourceType: accountResourceType, Name: account.Id}
+	case banking.AccountStatus_ACCOUNT_STATUS_CLOSED:
+		return &ResourceError{Err: AccountClosedError, ResourceType: accountResourceType, Name: account.Id}
+	}
+	return nil
+}
+
+// checkCanClose returns an error wrapping a *ResourceError for
+// AccountFrozenError if account is frozen, as a freeze must be lifted
+// before the account can be closed.
+func checkCanClose(account *banking.Account) error {
+	if account.Status == banking.AccountStatus_ACCOUNT_STATUS_FROZEN {
+		err := &ResourceError{Err: AccountFrozenError, ResourceType: accountResourceType, Name: account.Id}
+		return fmt.Errorf("%w: unfreeze it before closing it", err)
+	}
+	return nil
+}
+
+// checkCanReceive returns a *ResourceError if account's status does not
+// allow it to be credited.
+func checkCanReceive(account *banking.Account) error {
+	if account.Status == banking.AccountStatus_ACCOUNT_STATUS_CLOSED {
+		return &ResourceError{Err: AccountClosedError, ResourceType: accountResourceType, Name: account.Id}
+	}
+	return nil
+}
+
+// Store is the persistence layer behind a Server. Implementations must be
+// safe for concurrent use, and must never hand out messages that they
+// continue to mutate internally. Operations are given the context of the
+// call they are made for, so that they can be traced as part of it.
+type Store interface {
+	// Open prepares the store for use, e.g. by recovering persisted state.
+	Open() error
+	// Close flushes and releases any resources held by the store.
+	Close() error
+	// CreateAccount adds a new account. It returns a *ResourceError wrapping
+	// AccountExistsError if the ID is taken.
+	CreateAccount(ctx context.Context, account *banking.Account) error
+	// GetAccount returns the account with the given ID, or a *ResourceError
+	// wrapping AccountNotFoundError.
+	GetAccount(ctx context.Context, id string) (*banking.Account, error)
+	// ListAccounts returns every account in the store.
+	ListAccounts(ctx context.Context) ([]*banking.Account, error)
+	// ScanAccounts calls fn with each account in order, starting strictly
+	// after the cursor after if it is not nil, until fn returns false. fn is
+	// called with the store locked and with the store's own copy of each
+	// account, so it must not modify or retain it, or call back into the
+	// store.
+	ScanAccounts(ctx context.Context, order Ordering[*banking.Account], after *Cursor, fn func(account *banking.Account) bool) error
+	// GetTransaction returns the transaction with the given ID, or a
+	// *ResourceError wrapping TransactionNotFoundError.
+	GetTransaction(ctx context.Context, id string) (*banking.Transaction, error)
+	// ScanTransactions calls fn with each transaction as ScanAccounts does.
+	ScanTransactions(ctx context.Context, order Ordering[*banking.Transaction], after *Cursor, fn func(tx *banking.Transaction) bool) error
+	// Transfer atomically debits tx.FromAccountId, credits tx.ToAccountId and
+	// records tx. Either all three happen or none do. The receiver is
+	// credited tx.CreditAmount if set, or tx.Amount otherwise, and each must
+	// match the currency of its account. It returns an
+	// *InsufficientFundsError if the debit is not allowed by the sender's
+	// overdraft policy, and a *ResourceError wrapping TransactionExistsError
+	// if tx.TransactionId is already taken. Frozen and closed accounts
+	// cannot be debited, and closed accounts cannot be credited.
+	//
+	// If tx.ReversalOf is set, tx must move funds back along that
+	// transaction, and its ReversedAmount and Status are updated in the same
+	// commit. It returns an error wrapping ReversalExceedsOriginalError if
+	// more than the original amount would be refunded in total, and one
+	// wrapping TransactionNotReversibleError if the original is itself a
+	// reversal.
+	Transfer(ctx context.Context, tx *banking.Transaction) error
+	// TransferBatch applies each transfer in txs as Transfer would, in order,
+	// and commits those that succeed together. It returns one error per
+	// transfer, nil for those that were posted.
+	TransferBatch(ctx context.Context, txs []*banking.Transaction) []error
+	// RecordFailedTransfer records tx, which must have status
+	// TRANSACTION_STATUS_FAILED, without moving any funds. It returns a
+	// *ResourceError wrapping TransactionExistsError if tx.TransactionId is
+	// already taken.
+	RecordFailedTransfer(ctx context.Context, tx *banking.Transaction) error
+	// UpdateAccount calls update with a copy of the account with the given
+	// ID, then stores the copy unless update returns an error. update is
+	// called with the store locked.
+	UpdateAccount(ctx context.Context, id string, update func(account *banking.Account) error) (*banking.Account, error)
+	// SetAccountStatus changes the status of the account with the given ID
+	// and records reason. If sweep is not nil it is posted as by Transfer in
+	// the same commit. Closed accounts cannot change status, and returns a
+	// *ResourceError wrapping AccountClosedError for them. Accounts can only
+	// be closed once their balance is zero and nothing is held on them,
+	// otherwise an error wrapping AccountNotEmptyError is returned. Frozen
+	// accounts cannot be closed until they are unfrozen, and return an
+	// error wrapping AccountFrozenError before any sweep is attempted.
+	SetAccountStatus(ctx context.Context, id string, status banking.AccountStatus, reason string, sweep *banking.Transaction) (*banking.Account, error)
+	// PostJournalEntry atomically adds every leg of entry to its account and
+	// records entry. The legs must sum to zero in each currency, and each
+	// must match the currency of its account. It returns an
+	// *InsufficientFundsError if any debited account would go past what its
+	// overdraft policy allows, and a *ResourceError wrapping
+	// JournalEntryExistsError if entry.EntryId is already taken.
+	PostJournalEntry(ctx context.Context, entry *banking.JournalEntry) error
+	// ScanJournalEntries calls fn with each journal entry as ScanAccounts
+	// does.
+	ScanJournalEntries(ctx context.Context, order Ordering[*banking.JournalEntry], after *Cursor, fn func(entry *banking.JournalEntry) bool) error
+	// AuthorizeHold records tx, which must be TRANSACTION_STATUS_PENDING, and
+	// adds tx.Amount to the sender's HeldAmount without changing either
+	// balance. It checks tx as Transfer would, so the hold is refused with an
+	// *InsufficientFundsError if the sender could not pay it now.
+	AuthorizeHold(ctx context.Context, tx *banking.Transaction) error
+	// CaptureHold releases the hold placed by the pending transaction with
+	// the given ID and posts amount of it, or all of it if amount is nil, as
+	// Transfer would. It returns an error wrapping HoldNotPendingError if the
+	// transaction is not pending, HoldExpiredError if it has expired, and
+	// CaptureExceedsAuthorizationError if amount is more than was authorised.
+	CaptureHold(ctx context.Context, id string, amount *banking.Money) (*banking.Transaction, error)
+	// VoidHold releases the hold placed by the pending transaction with the
+	// given ID and marks it voided with reason. It returns an error wrapping
+	// HoldNotPendingError if the transaction is not pending.
+	VoidHold(ctx context.Context, id string, reason string) (*banking.Transaction, error)
+	// ExpireHolds releases every hold that expired before now and marks its
+	// transaction expired, returning their IDs in the order they expired.
+	ExpireHolds(ctx context.Context, now time.Time) ([]string, error)
+	// CreateSchedule adds a new schedule. It returns a *ResourceError wrapping
+	// ScheduleExistsError if the ID is taken.
+	CreateSchedule(ctx context.Context, schedule *banking.Schedule) error
+	// GetSchedule returns the schedule with the given ID, or a
+	// *ResourceError wrapping ScheduleNotFoundError.
+	GetSchedule(ctx context.Context, id string) (*banking.Schedule, error)
+	// ScanSchedules calls fn with each schedule as ScanAccounts does.
+	ScanSchedules(ctx context.Context, order Ordering[*banking.Schedule], after *Cursor, fn func(schedule *banking.Schedule) bool) error
+	// UpdateSchedule calls update with a copy of the schedule with the given
+	// ID, then stores the copy unless update returns an error. update is
+	// called with the store locked.
+	UpdateSchedule(ctx context.Context, id string, update func(schedule *banking.Schedule) error) (*banking.Schedule, error)
+	// PutIdempotencyRecord stores record, replacing any record with the same
+	// key.
+	PutIdempotencyRecord(ctx context.Context, record *IdempotencyRecord) error
+	// GetIdempotencyRecord returns the record with the given key, or nil if
+	// there is none or it has expired.
+	GetIdempotencyRecord(ctx context.Context, key string) (*IdempotencyRecord, error)
+	// OnCommit registers fn to be called after every committed transfer,
+	// hold or journal entry with the *banking.Transaction or *banking.JournalEntry
+	// and the new state of the accounts it changed. Calls are made in commit
+	// order, with the store locked, so fn must not block, call back into the
+	// store or retain its arguments.
+	OnCommit(fn func(cause proto.Message, accounts []*banking.Account))
+	// OnAccountStatus registers fn to be called whenever an account is
+	// created or changes status, with the account before the change (nil
+	// for new accounts) and after it. Calls are made with the store locked,
+	// so fn must not block, call back into the store or retain its
+	// arguments.
+	OnAccountStatus(fn func(before, after *banking.Account))
+}
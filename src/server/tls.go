@@ -0,0 +1,125 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+)
+
+var NoCertificatesError = errors.New("No PEM certificates found.")
+
+// NewServerTLS returns credentials that serve the certificate in certFile
+// and keyFile. When clientCAFile is set, clients must present a certificate
+// signed by one of its CAs (mutual TLS).
+//
+// The files are checked for changes on every handshake, so a rotated
+// certificate is served to new connections without a restart. If the new
+// files cannot be loaded, for example because only one of them has been
+// replaced so far, the previous certificate is served until they can.
+// Reloads, and failures to reload, are logged to logger.
+func NewServerTLS(certFile, keyFile, clientCAFile string, logger *slog.Logger) (credentials.TransportCredentials, error) {
+	r := &tlsReloader{certFile: certFile, keyFile: keyFile, clientCAFile: clientCAFile, logger: logger}
+	if _, err := r.config(); err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.config()
+		},
+	}), nil
+}
+
+// tlsReloader caches the tls.Config built from its files, rebuilding it
+// when any of them has been modified.
+type tlsReloader struct {
+	certFile, keyFile, clientCAFile string
+	logger                          *slog.Logger
+
+	mu      sync.Mutex
+	cfg     *tls.Config
+	modTime []time.Time
+}
+
+func (r *tlsReloader) config() (*tls.Config, error) {
+	modTime, err := r.modTimes()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err == nil && r.cfg != nil && slices.EqualFunc(modTime, r.modTime, time.Time.Equal) {
+		return r.cfg, nil
+	}
+	if err == nil {
+		var cfg *tls.Config
+		if cfg, err = r.load(); err == nil {
+			if r.cfg != nil {
+				r.logger.Info("Reloaded TLS certificate", "certFile", r.certFile)
+			}
+			r.cfg, r.modTime = cfg, modTime
+			return cfg, nil
+		}
+	}
+	if r.cfg == nil {
+		return nil, err
+	}
+	r.logger.Warn("Failed to reload TLS certificate, serving the previous one", "certFile", r.certFile, "error", err)
+	// Try again on the next handshake rather than logging on every one.
+	r.modTime = modTime
+	return r.cfg, nil
+}
+
+func (r *tlsReloader) modTimes() ([]time.Time, error) {
+	var modTime []time.Time
+	for _, file := range []string{r.certFile, r.keyFile, r.clientCAFile} {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		modTime = append(modTime, info.ModTime())
+	}
+	return modTime, nil
+}
+
+func (r *tlsReloader) load() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		// GetConfigForClient replaces the config that credentials.NewTLS
+		// added HTTP/2 to.
+		NextProtos: []string{"h2"},
+	}
+	if r.clientCAFile != "" {
+		if cfg.ClientCAs, err = loadCertPool(r.clientCAFile); err != nil {
+			return nil, err
+		}
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg, nil
+}
+
+// loadCertPool reads the PEM encoded CA certificates in file.
+func loadCertPool(file string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("%s: %w", file, NoCertificatesError)
+	}
+	return pool, nil
+}
@@ -0,0 +1,228 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// testCA is an ephemeral certificate authority for tests.
+type testCA struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key, certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM certificate and key for commonName signed by ca. Server
+// certificates are valid for localhost and 127.0.0.1.
+func (ca *testCA) issue(t *testing.T, commonName string, client bool) (certPEM, keyPEM []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	if client {
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+		template.DNSNames, template.IPAddresses = nil, nil
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// clientCredentials returns credentials that trust ca and, when certPEM is
+// set, present that client certificate.
+func (ca *testCA) clientCredentials(t *testing.T, certPEM, keyPEM []byte) credentials.TransportCredentials {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	cfg := &tls.Config{RootCAs: pool}
+	if certPEM != nil {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		require.NoError(t, err)
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(cfg)
+}
+
+// writeTestFile writes data to name in dir, dating it mod so that rewrites
+// within the file system's timestamp resolution are still seen as changes.
+func writeTestFile(t *testing.T, dir, name string, data []byte, mod time.Time) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	require.NoError(t, os.Chtimes(path, mod, mod))
+	return path
+}
+
+// startTestServer serves s on a free local port and returns its address
+// once s is ready to handle calls.
+func startTestServer(t *testing.T, s *Server) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	served := make(chan error, 1)
+	go func() { served <- s.Serve(listener) }()
+	for !s.IsRunning() {
+		select {
+		case err := <-served:
+			t.Fatalf("Server stopped before it was running: %v", err)
+		case <-time.After(time.Millisecond):
+		}
+	}
+	t.Cleanup(func() {
+		s.GracefulStop()
+		assert.NoError(t, <-served)
+	})
+	return listener.Addr().String()
+}
+
+// ping makes a Ping call over a new connection to addr.
+func ping(t *testing.T, addr string, creds credentials.TransportCredentials) error {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = banking.NewBankingServiceClient(conn).Ping(ctx, &banking.PingRequest{Message: "ping"})
+	return err
+}
+
+func TestServerTLS(t *testing.T) {
+	dir, now := t.TempDir(), time.Now()
+	ca := newTestCA(t)
+	certPEM, keyPEM := ca.issue(t, "server", false)
+	creds, err := NewServerTLS(
+		writeTestFile(t, dir, "server.pem", certPEM, now),
+		writeTestFile(t, dir, "server-key.pem", keyPEM, now),
+		"",
+		discardLogger(),
+	)
+	require.NoError(t, err)
+	s := getNewTestServer()
+	s.Credentials = creds
+	addr := startTestServer(t, s)
+
+	assert.NoError(t, ping(t, addr, ca.clientCredentials(t, nil, nil)))
+	assert.Error(t, ping(t, addr, insecure.NewCredentials()), "plaintext")
+	assert.Error(t, ping(t, addr, newTestCA(t).clientCredentials(t, nil, nil)), "untrusted server certificate")
+}
+
+func TestServerTLS_Mutual(t *testing.T) {
+	dir, now := t.TempDir(), time.Now()
+	ca := newTestCA(t)
+	certPEM, keyPEM := ca.issue(t, "server", false)
+	creds, err := NewServerTLS(
+		writeTestFile(t, dir, "server.pem", certPEM, now),
+		writeTestFile(t, dir, "server-key.pem", keyPEM, now),
+		writeTestFile(t, dir, "client-ca.pem", ca.certPEM, now),
+		discardLogger(),
+	)
+	require.NoError(t, err)
+	s := getNewTestServer()
+	s.Credentials = creds
+	addr := startTestServer(t, s)
+
+	clientCert, clientKey := ca.issue(t, "client", true)
+	assert.NoError(t, ping(t, addr, ca.clientCredentials(t, clientCert, clientKey)))
+	assert.Error(t, ping(t, addr, ca.clientCredentials(t, nil, nil)), "no client certificate")
+	otherCert, otherKey := newTestCA(t).issue(t, "client", true)
+	assert.Error(t, ping(t, addr, ca.clientCredentials(t, otherCert, otherKey)), "untrusted client certificate")
+}
+
+func TestServerTLS_Reload(t *testing.T) {
+	dir, now := t.TempDir(), time.Now()
+	ca := newTestCA(t)
+	certPEM, keyPEM := ca.issue(t, "server", false)
+	certFile := writeTestFile(t, dir, "server.pem", certPEM, now)
+	keyFile := writeTestFile(t, dir, "server-key.pem", keyPEM, now)
+	creds, err := NewServerTLS(certFile, keyFile, "", discardLogger())
+	require.NoError(t, err)
+	s := getNewTestServer()
+	s.Credentials = creds
+	addr := startTestServer(t, s)
+	require.NoError(t, ping(t, addr, ca.clientCredentials(t, nil, nil)))
+
+	rotated := newTestCA(t)
+	certPEM, keyPEM = rotated.issue(t, "server", false)
+
+	// Half way through a rotation the old certificate is still served.
+	writeTestFile(t, dir, "server.pem", certPEM, now.Add(time.Second))
+	assert.NoError(t, ping(t, addr, ca.clientCredentials(t, nil, nil)))
+
+	writeTestFile(t, dir, "server-key.pem", keyPEM, now.Add(2*time.Second))
+	assert.NoError(t, ping(t, addr, rotated.clientCredentials(t, nil, nil)))
+	assert.Error(t, ping(t, addr, ca.clientCredentials(t, nil, nil)))
+}
+
+func TestNewServerTLS_Invalid(t *testing.T) {
+	dir, now := t.TempDir(), time.Now()
+	ca := newTestCA(t)
+	certPEM, keyPEM := ca.issue(t, "server", false)
+	certFile := writeTestFile(t, dir, "server.pem", certPEM, now)
+	keyFile := writeTestFile(t, dir, "server-key.pem", keyPEM, now)
+
+	_, err := NewServerTLS(certFile, filepath.Join(dir, "missing.pem"), "", discardLogger())
+	assert.Error(t, err)
+	_, err = NewServerTLS(certFile, certFile, "", discardLogger())
+	assert.Error(t, err)
+	_, err = NewServerTLS(certFile, keyFile, keyFile, discardLogger())
+	assert.ErrorIs(t, err, NoCertificatesError)
+}
+
+func TestServer_Lifecycle(t *testing.T) {
+	s := getNewTestServer()
+	s.GracefulStop() // never served
+
+	addr := startTestServer(t, s)
+	require.NoError(t, ping(t, addr, insecure.NewCredentials()))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	assert.ErrorIs(t, s.Serve(listener), ServerIsRunningError)
+}
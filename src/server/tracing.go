@@ -0,0 +1,7 @@
+package server
+
+import "go.opentelemetry.io/otel"
+
+// tracer makes the spans of the server and its stores. It follows the
+// global tracer provider, which telemetry.SetupTracing installs.
+var tracer = otel.Tracer("github.com/bryanvaz/grpc-gl/src/server")
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/bryanvaz/grpc-gl/src/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	spanRecorderOnce sync.Once
+	spanRecorder     *tracetest.SpanRecorder
+	recorderProvider *sdktrace.TracerProvider
+)
+
+// recordSpans makes the global tracer provider one that records every span
+// in memory. The package tracer is bound to the first provider installed,
+// so every test that installs one calls this first.
+func recordSpans() (*tracetest.SpanRecorder, *sdktrace.TracerProvider) {
+	spanRecorderOnce.Do(func() {
+		spanRecorder = tracetest.NewSpanRecorder()
+		recorderProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+		otel.SetTracerProvider(recorderProvider)
+	})
+	return spanRecorder, recorderProvider
+}
+
+func TestServer_Tracing(t *testing.T) {
+	recorder, provider := recordSpans()
+	_, err := telemetry.SetupTracing(context.Background(), "test", telemetry.TracingConfig{Exporter: "none"})
+	require.NoError(t, err)
+
+	s := getNewTestServer()
+	addr := startTestServer(t, s)
+	conn, err := grpc.Dial(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := banking.NewBankingServiceClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	account, err := client.CreateAccount(ctx, &banking.AccountRequest{InitialBalance: usd(100)})
+	require.NoError(t, err)
+	ctx, root := provider.Tracer("test").Start(ctx, "balance")
+	_, err = client.GetBalance(ctx, &banking.BalanceRequest{AccountId: account.AccountId})
+	require.NoError(t, err)
+	root.End()
+
+	traceID := root.SpanContext().TraceID()
+	names := map[string]bool{}
+	for _, span := range recorder.Ended() {
+		if span.SpanContext().TraceID() == traceID {
+			names[span.Name()] = true
+		}
+	}
+	assert.True(t, names["banking.BankingService/GetBalance"], "client and server RPC spans, got %v", names)
+	assert.True(t, names["store.GetAccount"], "got %v", names)
+	assert.True(t, names["store.lock"], "got %v", names)
+	assert.False(t, names["store.CreateAccount"], "earlier call has its own trace")
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+)
+
+// transactionsByCreatedAt orders transactions by creation time, breaking
+// ties by ID.
+var transactionsByCreatedAt = Ordering[*banking.Transaction]{
+	Name: "createdAt",
+	Key:  func(tx *banking.Transaction) []byte { return timeKey(tx.CreatedAt) },
+	ID:   func(tx *banking.Transaction) string { return tx.TransactionId },
+}
+
+// holdsByExpiry orders the pending holds by when they lapse, so that
+// sweeping them does not visit every transaction.
+var holdsByExpiry = Ordering[*banking.Transaction]{
+	Name: "expiresAt",
+	Key:  func(tx *banking.Transaction) []byte { return timeKey(tx.ExpiresAt) },
+	ID:   func(tx *banking.Transaction) string { return tx.TransactionId },
+	Where: func(tx *banking.Transaction) bool {
+		return tx.Status == banking.TransactionStatus_TRANSACTION_STATUS_PENDING && tx.ExpiresAt != nil
+	},
+}
+
+// transactionOrdering parses orderBy into an ordering of transactions. It
+// returns false if orderBy is not recognised.
+func transactionOrdering(orderBy string) (Ordering[*banking.Transaction], bool) {
+	field, desc := parseOrderBy(orderBy, "createdAt")
+	order := transactionsByCreatedAt
+	order.Desc = desc
+	return order, field == "createdAt"
+}
+
+// transactionStatus returns the status of tx, treating transactions recorded
+// before statuses were introduced as posted.
+func transactionStatus(tx *banking.Transaction) banking.TransactionStatus {
+	if tx.Status == banking.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED {
+		return banking.TransactionStatus_TRANSACTION_STATUS_POSTED
+	}
+	return tx.Status
+}
+
+// matchTransaction reports whether tx matches every filter in req.
+func matchTransaction(tx *banking.Transaction, req *banking.ListTransactionsRequest) bool {
+	if req.AccountId != "" {
+		sender := tx.FromAccountId == req.AccountId
+		receiver := tx.ToAccountId == req.AccountId
+		switch req.Role {
+		case banking.TransactionRole_TRANSACTION_ROLE_SENDER:
+			if !sender {
+				return false
+			}
+		case banking.TransactionRole_TRANSACTION_ROLE_RECEIVER:
+			if !receiver {
+				return false
+			}
+		default:
+			if !sender && !receiver {
+				return false
+			}
+		}
+	}
+	switch {
+	case req.StartTime != nil && tx.CreatedAt.AsTime().Before(req.StartTime.AsTime()):
+		return false
+	case req.EndTime != nil && !tx.CreatedAt.AsTime().Before(req.EndTime.AsTime()):
+		return false
+	case req.MinAmount != nil && (tx.Amount.GetCurrencyCode() != req.MinAmount.CurrencyCode ||
+		moneyNanos(tx.Amount).Cmp(moneyNanos(req.MinAmount)) < 0):
+		return false
+	case req.MaxAmount != nil && (tx.Amount.GetCurrencyCode() != req.MaxAmount.CurrencyCode ||
+		moneyNanos(tx.Amount).Cmp(moneyNanos(req.MaxAmount)) > 0):
+		return false
+	case req.Reference != "" && tx.Reference != req.Reference:
+		return false
+	case req.Status != banking.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED && transactionStatus(tx) != req.Status:
+		return false
+	}
+	return true
+}
+
+// listTransactions applies the filters, ordering and pagination of req to
+// the transactions in store.
+func listTransactions(ctx context.Context, store Store, req *banking.ListTransactionsRequest) (*banking.ListTransactionsResponse, error) {
+	order, ok := transactionOrdering(req.OrderBy)
+	if !ok {
+		return nil, invalidArgument(FieldViolation{"orderBy", "must be createdAt, optionally followed by desc"})
+	}
+
+	match := func(tx *banking.Transaction) bool { return matchTransaction(tx, req) }
+	page, next, err := listPage(ctx, req, order, match, store.ScanTransactions)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &banking.ListTransactionsResponse{Transactions: page, NextPageToken: next}, nil
+}
@@ -0,0 +1,171 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func makeTestTransfers(t *testing.T, s *Server, from, to string, amounts ...int64) []string {
+	ids := make([]string, len(amounts))
+	for i, amount := range amounts {
+		res, err := s.MakeTransaction(context.Background(), &banking.TransactionRequest{
+			FromAccountId: from,
+			ToAccountId:   to,
+			Amount:        usd(amount),
+		})
+		require.NoError(t, err)
+		ids[i] = res.TransactionId
+	}
+	return ids
+}
+
+func transactionIDs(transactions []*banking.Transaction) []string {
+	ids := make([]string, len(transactions))
+	for i, tx := range transactions {
+		ids[i] = tx.TransactionId
+	}
+	return ids
+}
+
+func TestListTransactions_Pagination(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 1000, 1000)
+	ids := makeTestTransfers(t, s, accounts[0], accounts[1], 1, 2, 3, 4, 5)
+
+	req := &banking.ListTransactionsRequest{AccountId: accounts[0], PageSize: 2}
+	var seen []string
+	for page := 0; ; page++ {
+		res, err := s.ListTransactions(context.Background(), req)
+		require.NoError(t, err)
+		seen = append(seen, transactionIDs(res.Transactions)...)
+		if res.NextPageToken == "" {
+			assert.Equal(t, 2, page)
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	assert.Equal(t, ids, seen)
+}
+
+func TestListTransactions_PagesNewestFirstUnderInserts(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 1000, 1000)
+	ids := makeTestTransfers(t, s, accounts[0], accounts[1], 1, 2, 3)
+
+	req := &banking.ListTransactionsRequest{OrderBy: "createdAt desc", PageSize: 2}
+	res, err := s.ListTransactions(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[2], ids[1]}, transactionIDs(res.Transactions))
+	assert.Less(t, len(res.NextPageToken), 128)
+	makeTestTransfers(t, s, accounts[0], accounts[1], 4)
+
+	req.PageToken = res.NextPageToken
+	res, err = s.ListTransactions(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{ids[0]}, transactionIDs(res.Transactions))
+	assert.Empty(t, res.NextPageToken)
+}
+
+func TestListTransactions_FiltersAndOrder(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 1000, 1000, 1000)
+	out := makeTestTransfers(t, s, accounts[0], accounts[1], 10, 20)
+	in := makeTestTransfers(t, s, accounts[1], accounts[0], 30)
+	other := makeTestTransfers(t, s, accounts[1], accounts[2], 40)
+
+	res, err := s.ListTransactions(context.Background(), &banking.ListTransactionsRequest{
+		AccountId: accounts[0],
+		OrderBy:   "createdAt desc",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{in[0], out[1], out[0]}, transactionIDs(res.Transactions))
+
+	res, err = s.ListTransactions(context.Background(), &banking.ListTransactionsRequest{
+		AccountId: accounts[0],
+		Role:      banking.TransactionRole_TRANSACTION_ROLE_SENDER,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, out, transactionIDs(res.Transactions))
+
+	res, err = s.ListTransactions(context.Background(), &banking.ListTransactionsRequest{
+		AccountId: accounts[1],
+		Role:      banking.TransactionRole_TRANSACTION_ROLE_RECEIVER,
+		MinAmount: usd(15),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{out[1]}, transactionIDs(res.Transactions))
+
+	first, _ := s.store.GetTransaction(context.Background(), out[1])
+	last, _ := s.store.GetTransaction(context.Background(), other[0])
+	res, err = s.ListTransactions(context.Background(), &banking.ListTransactionsRequest{
+		StartTime: first.CreatedAt,
+		EndTime:   last.CreatedAt,
+		MaxAmount: usd(30),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{out[1], in[0]}, transactionIDs(res.Transactions))
+}
+
+func TestListTransactions_InvalidRequests(t *testing.T) {
+	s := getNewTestServer()
+
+	_, err := s.ListTransactions(context.Background(), &banking.ListTransactionsRequest{OrderBy: "amount"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.ListTransactions(context.Background(), &banking.ListTransactionsRequest{PageToken: "garbage"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMakeTransaction_RecordsDetails(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 0)
+
+	res, err := s.MakeTransaction(context.Background(), &banking.TransactionRequest{
+		FromAccountId: accounts[0],
+		ToAccountId:   accounts[1],
+		Amount:        usd(10),
+		Memo:          "March invoice",
+		Reference:     "INV-42",
+	})
+	require.NoError(t, err)
+	tx := transactionDetails(t, s, res.TransactionId)
+	assert.Equal(t, banking.TransactionStatus_TRANSACTION_STATUS_POSTED, tx.Status)
+	assert.Equal(t, "March invoice", tx.Memo)
+	assert.Equal(t, "INV-42", tx.Reference)
+	require.NotNil(t, tx.PostedAt)
+	assert.False(t, tx.PostedAt.AsTime().Before(tx.CreatedAt.AsTime()))
+
+	// Declined transfers are recorded as failed without moving funds.
+	_, err = s.MakeTransaction(context.Background(), &banking.TransactionRequest{
+		FromAccountId: accounts[0],
+		ToAccountId:   accounts[1],
+		Amount:        usd(1000),
+		Reference:     "INV-43",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	list, err := s.ListTransactions(context.Background(), &banking.ListTransactionsRequest{Reference: "INV-43"})
+	require.NoError(t, err)
+	require.Len(t, list.Transactions, 1)
+	failed := list.Transactions[0]
+	assert.Equal(t, banking.TransactionStatus_TRANSACTION_STATUS_FAILED, failed.Status)
+	assert.Nil(t, failed.PostedAt)
+	assert.Contains(t, failed.FailureReason, "Insufficient funds")
+
+	list, err = s.ListTransactions(context.Background(), &banking.ListTransactionsRequest{
+		Status: banking.TransactionStatus_TRANSACTION_STATUS_POSTED,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{res.TransactionId}, transactionIDs(list.Transactions))
+
+	_, err = s.ReverseTransaction(context.Background(), &banking.ReverseTransactionRequest{TransactionId: failed.TransactionId})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	balance, _ := s.GetBalance(context.Background(), &banking.BalanceRequest{AccountId: accounts[0]})
+	assertProtoEqual(t, usd(90), balance.Balance)
+}
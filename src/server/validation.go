@@ -0,0 +1,181 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var moneyMessageName = (&banking.Money{}).ProtoReflect().Descriptor().FullName()
+
+// validationUnaryInterceptor rejects requests that break the FieldRules
+// declared on their message before the handler sees them.
+func validationUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// itemValidatedMethods are streaming methods that validate and authorise
+// each message themselves, so that one bad message fails only that item
+// rather than the whole stream.
+var itemValidatedMethods = map[string]bool{
+	banking.BankingService_BatchTransfer_FullMethodName: true,
+}
+
+// validationStreamInterceptor applies the same checks as
+// validationUnaryInterceptor to every message a client streams in.
+func validationStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if itemValidatedMethods[info.FullMethod] {
+		return handler(srv, ss)
+	}
+	return handler(srv, &validatingStream{ServerStream: ss})
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateRequest(m)
+}
+
+// validateRequest returns an INVALID_ARGUMENT error listing every
+// violated rule in req, or nil if req is valid or not a proto message.
+func validateRequest(req any) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	if violations := validateMessage(msg.ProtoReflect(), ""); len(violations) > 0 {
+		return invalidArgument(violations...)
+	}
+	return nil
+}
+
+func validateMessage(m protoreflect.Message, prefix string) []FieldViolation {
+	var violations []FieldViolation
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+		set := m.Has(fd)
+
+		rules, _ := proto.GetExtension(fd.Options(), banking.E_Rules).(*banking.FieldRules)
+		if rules != nil {
+			violations = append(violations, checkRules(m, fd, path, rules)...)
+		}
+
+		if !set || fd.Kind() != protoreflect.MessageKind || fd.IsMap() {
+			continue
+		}
+		if fd.IsList() {
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				violations = append(violations, validateSubMessage(list.Get(j).Message(), fmt.Sprintf("%s[%d]", path, j))...)
+			}
+			continue
+		}
+		violations = append(violations, validateSubMessage(m.Get(fd).Message(), path)...)
+	}
+	return violations
+}
+
+func validateSubMessage(m protoreflect.Message, path string) []FieldViolation {
+	if m.Descriptor().FullName() == moneyMessageName {
+		if err := validateMoney(m.Interface().(*banking.Money)); err != nil {
+			return []FieldViolation{{path, err.Error()}}
+		}
+		return nil
+	}
+	return validateMessage(m, path+".")
+}
+
+func checkRules(m protoreflect.Message, fd protoreflect.FieldDescriptor, path string, rules *banking.FieldRules) []FieldViolation {
+	var violations []FieldViolation
+	addAt := func(path, format string, args ...any) {
+		violations = append(violations, FieldViolation{path, fmt.Sprintf(format, args...)})
+	}
+	add := func(format string, args ...any) { addAt(path, format, args...) }
+
+	if !m.Has(fd) {
+		if rules.Required {
+			add("is required")
+		}
+		return violations
+	}
+	v := m.Get(fd)
+
+	// The string rules of a repeated field apply to each of its items.
+	checkString := func(path, s string) {
+		if rules.Uuid {
+			if id, err := uuid.Parse(s); err != nil || id.String() != s {
+				addAt(path, "must be a canonical UUID")
+			}
+		}
+		if rules.MaxLen > 0 && uint32(len(s)) > rules.MaxLen {
+			addAt(path, "must be at most %d bytes", rules.MaxLen)
+		}
+	}
+	if fd.Kind() == protoreflect.StringKind {
+		if fd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				checkString(fmt.Sprintf("%s[%d]", path, i), list.Get(i).String())
+			}
+		} else {
+			checkString(path, v.String())
+		}
+	}
+
+	if rules.Positive || rules.NonNegative {
+		if sign, ok := fieldSign(fd, v); ok {
+			switch {
+			case rules.Positive && sign <= 0:
+				add("must be greater than zero")
+			case rules.NonNegative && sign < 0:
+				add("must not be negative")
+			}
+		}
+	}
+
+	if rules.NotEqualField != "" {
+		other := m.Descriptor().Fields().ByName(protoreflect.Name(rules.NotEqualField))
+		if other != nil && m.Has(other) && m.Get(other).Equal(v) {
+			add("must differ from %s", rules.NotEqualField)
+		}
+	}
+	return violations
+}
+
+// fieldSign returns the sign of a numeric or Money field value, or false
+// for any other kind of field.
+func fieldSign(fd protoreflect.FieldDescriptor, v protoreflect.Value) (int, bool) {
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n := v.Int()
+		switch {
+		case n > 0:
+			return 1, true
+		case n < 0:
+			return -1, true
+		}
+		return 0, true
+	case protoreflect.MessageKind:
+		if fd.Message().FullName() != moneyMessageName {
+			return 0, false
+		}
+		return moneyNanos(v.Message().Interface().(*banking.Money)).Sign(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func violatedFields(t *testing.T, err error) map[string]string {
+	t.Helper()
+	fields := make(map[string]string)
+	for _, v := range errorDetail[*errdetails.BadRequest](t, err).FieldViolations {
+		fields[v.Field] = v.Description
+	}
+	return fields
+}
+
+func TestValidation_TransactionRequest(t *testing.T) {
+	id := uuid.New().String()
+	valid := &banking.TransactionRequest{FromAccountId: id, ToAccountId: uuid.New().String(), Amount: usd(10)}
+	assert.NoError(t, validateRequest(valid))
+
+	err := validateRequest(&banking.TransactionRequest{
+		FromAccountId: id,
+		ToAccountId:   id,
+		Amount:        usd(-10),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, map[string]string{
+		"toAccountId": "must differ from fromAccountId",
+		"amount":      "must be greater than zero",
+	}, violatedFields(t, err))
+
+	err = validateRequest(&banking.TransactionRequest{
+		FromAccountId: "not-a-uuid",
+		Amount:        &banking.Money{CurrencyCode: "usd", Units: 1},
+	})
+	fields := violatedFields(t, err)
+	assert.Equal(t, "must be a canonical UUID", fields["fromAccountId"])
+	assert.Equal(t, "is required", fields["toAccountId"])
+	assert.Contains(t, fields["amount"], "bad currency code")
+}
+
+func TestValidation_AccountRequest(t *testing.T) {
+	assert.NoError(t, validateRequest(&banking.AccountRequest{InitialBalance: usd(0)}))
+
+	err := validateRequest(&banking.AccountRequest{
+		InitialBalance: usd(-1),
+		OverdraftLimit: usd(-5),
+	})
+	assert.Equal(t, map[string]string{
+		"initialBalance": "must not be negative",
+		"overdraftLimit": "must not be negative",
+	}, violatedFields(t, err))
+}
+
+func TestValidation_UnaryInterceptor(t *testing.T) {
+	called := false
+	handler := func(ctx context.Context, req any) (any, error) {
+		called = true
+		return &banking.BalanceResponse{}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/banking.BankingService/GetBalance"}
+
+	_, err := validationUnaryInterceptor(context.Background(), &banking.BalanceRequest{}, info, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.False(t, called)
+
+	_, err = validationUnaryInterceptor(context.Background(), &banking.BalanceRequest{AccountId: uuid.New().String()}, info, handler)
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestValidation_RepeatedMessages(t *testing.T) {
+	err := validateRequest(&banking.JournalEntryRequest{
+		Legs: []*banking.JournalLeg{
+			{AccountId: uuid.New().String(), Amount: usd(-10)},
+			{AccountId: "not-a-uuid", Amount: &banking.Money{CurrencyCode: "USD", Nanos: -1_000_000_000}},
+		},
+	})
+	fields := violatedFields(t, err)
+	assert.Len(t, fields, 2)
+	assert.Contains(t, fields, "legs[1].accountId")
+	assert.Contains(t, fields, "legs[1].amount")
+
+	err = validateRequest(&banking.JournalEntryRequest{})
+	assert.Contains(t, violatedFields(t, err), "legs")
+}
+
+func TestValidation_RepeatedStrings(t *testing.T) {
+	err := validateRequest(&banking.WatchAccountRequest{
+		AccountIds: []string{uuid.New().String(), "not-a-uuid"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	fields := violatedFields(t, err)
+	assert.Len(t, fields, 1)
+	assert.Equal(t, "must be a canonical UUID", fields["accountIds[1]"])
+
+	assert.NoError(t, validateRequest(&banking.WatchAccountRequest{AccountIds: []string{uuid.New().String()}}))
+}
@@ -0,0 +1,589 @@
+package server
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// On disk a WALStore is a directory holding at most one snapshot and a
+// series of numbered log segments. Every file is a sequence of frames:
+//
+//	[4 byte little-endian payload length][4 byte CRC-32C of payload][payload]
+//
+// A payload is a protobuf-encoded list of records, each the full new state
+// of one account, transaction, journal entry, schedule or idempotency
+// record. Replaying a frame
+// is therefore idempotent and the store is recovered by loading the
+// snapshot and replaying every segment it does not already cover, in order.
+const (
+	walSnapshotFile   = "snapshot"
+	walSegmentPattern = "wal-%016d.log"
+
+	walFieldAccount      protowire.Number = 1
+	walFieldTransaction  protowire.Number = 2
+	walFieldJournalEntry protowire.Number = 3
+	walFieldSchedule     protowire.Number = 4
+	walFieldIdempotency  protowire.Number = 5
+	walFieldSegment      protowire.Number = 15
+
+	walFrameHeaderSize = 8
+)
+
+var walCRCTable = crc32.MakeTable(crc32.Castagnoli)
+
+var CorruptWALError = errors.New("Write-ahead log is corrupt")
+
+// WALFailedError is returned for every change once the log could not be
+// restored after a failed append. The store keeps serving reads, but must
+// be restarted before it accepts changes again.
+var WALFailedError = errors.New("Write-ahead log failed")
+
+// walFile is the open log segment.
+type walFile interface {
+	io.Writer
+	Sync() error
+	Truncate(size int64) error
+	Close() error
+}
+
+// WALStore is a MemoryStore whose changes are appended to an fsync'd
+// write-ahead log on local disk before they are applied, so that state
+// survives restarts. The log is compacted into a snapshot every
+// SnapshotInterval.
+type WALStore struct {
+	*MemoryStore
+	Dir              string
+	SnapshotInterval time.Duration
+	Logger           *slog.Logger
+
+	segment uint64
+	log     walFile
+	size    int64 // of the log segment, as of the last acknowledged append
+	failed  error
+	dirty   bool
+	snapMtx sync.Mutex
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+func NewWALStore(dir string) *WALStore {
+	return &WALStore{
+		MemoryStore:      NewMemoryStore(),
+		Dir:              dir,
+		SnapshotInterval: 5 * time.Minute,
+		Logger:           slog.Default(),
+	}
+}
+
+// Open recovers the store from Dir and reopens the newest log segment for
+// appending.
+func (w *WALStore) Open() error {
+	if err := os.MkdirAll(w.Dir, 0o755); err != nil {
+		return err
+	}
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	next, err := w.loadSnapshot()
+	if err != nil {
+		return err
+	}
+	segments, err := w.segments()
+	if err != nil {
+		return err
+	}
+	for i, seg := range segments {
+		if seg < next {
+			// Already folded into the snapshot; left behind by a crash
+			// between writing the snapshot and compacting the log.
+			os.Remove(w.segmentPath(seg))
+			continue
+		}
+		if err := w.replaySegment(seg, i == len(segments)-1); err != nil {
+			return err
+		}
+		next = seg
+	}
+	w.reindex()
+
+	if err := w.openSegment(next); err != nil {
+		return err
+	}
+	w.commit = w.append
+
+	w.done = make(chan struct{})
+	if w.SnapshotInterval > 0 {
+		w.wg.Add(1)
+		go w.snapshotLoop()
+	}
+	return nil
+}
+
+// Close writes a final snapshot and closes the log.
+func (w *WALStore) Close() error {
+	if w.done == nil {
+		return nil
+	}
+	close(w.done)
+	w.wg.Wait()
+	w.done = nil
+
+	err := w.Snapshot()
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+	w.commit = nil
+	if cerr := w.log.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Snapshot writes the current state to disk and removes the log segments
+// it replaces. Writers are only blocked while the state is serialized.
+func (w *WALStore) Snapshot() error {
+	w.snapMtx.Lock()
+	defer w.snapMtx.Unlock()
+
+	w.mtx.Lock()
+	if w.failed != nil {
+		// The log may end in a frame that was never acknowledged. Leave it
+		// last, so that it is truncated when the store is reopened.
+		w.mtx.Unlock()
+		return w.failed
+	}
+	if !w.dirty || w.log == nil {
+		w.mtx.Unlock()
+		return nil
+	}
+	w.dirty = false
+	old := w.segment
+	payload := protowire.AppendTag(nil, walFieldSegment, protowire.VarintType)
+	payload = protowire.AppendVarint(payload, old+1)
+	payload, err := appendRecords(payload, &changeSet{
+		accounts:       mapValues(w.accounts),
+		transactions:   mapValues(w.transactions),
+		journalEntries: mapValues(w.journalEntries),
+		schedules:      mapValues(w.schedules),
+		idempotency:    unexpiredIdempotencyRecords(w.idempotency),
+	})
+	if err == nil {
+		err = w.openSegment(old + 1)
+	}
+	if err != nil {
+		w.dirty = true
+	}
+	w.mtx.Unlock()
+	if err != nil {
+		return err
+	}
+
+	tmp := filepath.Join(w.Dir, walSnapshotFile+".tmp")
+	if err := writeFileSync(tmp, appendFrame(nil, payload)); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(w.Dir, walSnapshotFile)); err != nil {
+		return err
+	}
+	if err := syncDir(w.Dir); err != nil {
+		return err
+	}
+
+	segments, err := w.segments()
+	if err != nil {
+		return err
+	}
+	for _, seg := range segments {
+		if seg <= old {
+			if err := os.Remove(w.segmentPath(seg)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (w *WALStore) snapshotLoop() {
+	defer w.wg.Done()
+	ticker := time.NewTicker(w.SnapshotInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+			if err := w.Snapshot(); err != nil {
+				w.Logger.Error("WALStore: snapshot failed", "error", err)
+			}
+		}
+	}
+}
+
+// append is the MemoryStore commit hook. It makes cs durable before the
+// MemoryStore applies it. A failed append is cut back off the log, so that
+// it is neither replayed nor mistaken for a torn write that hides the
+// appends after it.
+func (w *WALStore) append(cs *changeSet) error {
+	if w.failed != nil {
+		return w.failed
+	}
+	payload, err := appendRecords(nil, cs)
+	if err != nil {
+		return err
+	}
+	frame := appendFrame(nil, payload)
+	_, err = w.log.Write(frame)
+	if err == nil {
+		err = w.log.Sync()
+	}
+	if err != nil {
+		if terr := w.truncate(); terr != nil {
+			w.failed = fmt.Errorf("%w: %v", WALFailedError, terr)
+			w.Logger.Error("WALStore: rejecting changes until restarted", "error", w.failed)
+		}
+		return err
+	}
+	w.size += int64(len(frame))
+	w.dirty = true
+	return nil
+}
+
+// truncate cuts the log back to the end of the last acknowledged append.
+func (w *WALStore) truncate() error {
+	if err := w.log.Truncate(w.size); err != nil {
+		return err
+	}
+	return w.log.Sync()
+}
+
+// openSegment switches the log over to segment seg. Callers must hold mtx.
+func (w *WALStore) openSegment(seg uint64) error {
+	f, err := os.OpenFile(w.segmentPath(seg), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err == nil {
+		err = syncDir(w.Dir)
+	}
+	if err != nil {
+		f.Close()
+		return err
+	}
+	if w.log != nil {
+		w.log.Close()
+	}
+	w.log = f
+	w.size = info.Size()
+	w.segment = seg
+	return nil
+}
+
+// loadSnapshot loads the snapshot, if any, and returns the first segment
+// it does not cover.
+func (w *WALStore) loadSnapshot() (uint64, error) {
+	data, err := os.ReadFile(filepath.Join(w.Dir, walSnapshotFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	payload, n := readFrame(data)
+	if n != len(data) {
+		return 0, fmt.Errorf("%w: bad snapshot", CorruptWALError)
+	}
+	return w.replayRecords(payload)
+}
+
+// replaySegment applies every frame in seg. A torn frame at the end of the
+// last segment is the remains of a write that was never acknowledged, so
+// it is truncated; anywhere else it is corruption.
+func (w *WALStore) replaySegment(seg uint64, last bool) error {
+	path := w.segmentPath(seg)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if len(data) > 0 {
+		w.dirty = true
+	}
+	off := 0
+	for off < len(data) {
+		payload, n := readFrame(data[off:])
+		if n == 0 {
+			if !last {
+				return fmt.Errorf("%w: bad frame in %s at offset %d", CorruptWALError, path, off)
+			}
+			w.Logger.Warn("WALStore: truncating torn write", "path", path, "offset", off)
+			return os.Truncate(path, int64(off))
+		}
+		if _, err := w.replayRecords(payload); err != nil {
+			return err
+		}
+		off += n
+	}
+	return nil
+}
+
+// replayRecords applies a frame payload directly to the maps and returns
+// the segment number it carries, if any.
+func (w *WALStore) replayRecords(payload []byte) (uint64, error) {
+	var segment uint64
+	for len(payload) > 0 {
+		num, typ, n := protowire.ConsumeTag(payload)
+		if n < 0 {
+			return 0, CorruptWALError
+		}
+		payload = payload[n:]
+		if num == walFieldSegment && typ == protowire.VarintType {
+			segment, n = protowire.ConsumeVarint(payload)
+			if n < 0 {
+				return 0, CorruptWALError
+			}
+			payload = payload[n:]
+			continue
+		}
+		if typ != protowire.BytesType {
+			return 0, CorruptWALError
+		}
+		b, n := protowire.ConsumeBytes(payload)
+		if n < 0 {
+			return 0, CorruptWALError
+		}
+		payload = payload[n:]
+		switch num {
+		case walFieldAccount:
+			account := &banking.Account{}
+			if err := proto.Unmarshal(b, account); err != nil {
+				return 0, err
+			}
+			w.accounts[account.Id] = account
+		case walFieldTransaction:
+			transaction := &banking.Transaction{}
+			if err := proto.Unmarshal(b, transaction); err != nil {
+				return 0, err
+			}
+			w.transactions[transaction.TransactionId] = transaction
+		case walFieldJournalEntry:
+			entry := &banking.JournalEntry{}
+			if err := proto.Unmarshal(b, entry); err != nil {
+				return 0, err
+			}
+			w.journalEntries[entry.EntryId] = entry
+		case walFieldSchedule:
+			schedule := &banking.Schedule{}
+			if err := proto.Unmarshal(b, schedule); err != nil {
+				return 0, err
+			}
+			w.schedules[schedule.ScheduleId] = schedule
+		case walFieldIdempotency:
+			record, err := consumeIdempotencyRecord(b)
+			if err != nil {
+				return 0, err
+			}
+			w.idempotency[record.Key] = record
+		}
+	}
+	return segment, nil
+}
+
+func (w *WALStore) segments() ([]uint64, error) {
+	entries, err := os.ReadDir(w.Dir)
+	if err != nil {
+		return nil, err
+	}
+	var segments []uint64
+	for _, entry := range entries {
+		var seg uint64
+		if _, err := fmt.Sscanf(entry.Name(), walSegmentPattern, &seg); err == nil {
+			segments = append(segments, seg)
+		}
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+	return segments, nil
+}
+
+func (w *WALStore) segmentPath(seg uint64) string {
+	return filepath.Join(w.Dir, fmt.Sprintf(walSegmentPattern, seg))
+}
+
+func appendRecords(b []byte, cs *changeSet) ([]byte, error) {
+	for _, account := range cs.accounts {
+		data, err := proto.Marshal(account)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, walFieldAccount, protowire.BytesType)
+		b = protowire.AppendBytes(b, data)
+	}
+	for _, transaction := range cs.transactions {
+		data, err := proto.Marshal(transaction)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, walFieldTransaction, protowire.BytesType)
+		b = protowire.AppendBytes(b, data)
+	}
+	for _, entry := range cs.journalEntries {
+		data, err := proto.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, walFieldJournalEntry, protowire.BytesType)
+		b = protowire.AppendBytes(b, data)
+	}
+	for _, schedule := range cs.schedules {
+		data, err := proto.Marshal(schedule)
+		if err != nil {
+			return nil, err
+		}
+		b = protowire.AppendTag(b, walFieldSchedule, protowire.BytesType)
+		b = protowire.AppendBytes(b, data)
+	}
+	for _, record := range cs.idempotency {
+		b = protowire.AppendTag(b, walFieldIdempotency, protowire.BytesType)
+		b = protowire.AppendBytes(b, appendIdempotencyRecord(nil, record))
+	}
+	return b, nil
+}
+
+// Idempotency records are not protobuf messages, so they are encoded by
+// hand with these field numbers.
+const (
+	walIdempotencyKey         protowire.Number = 1
+	walIdempotencyFingerprint protowire.Number = 2
+	walIdempotencyResponse    protowire.Number = 3
+	walIdempotencyExpires     protowire.Number = 4 // Unix nanoseconds
+)
+
+func appendIdempotencyRecord(b []byte, record *IdempotencyRecord) []byte {
+	b = protowire.AppendTag(b, walIdempotencyKey, protowire.BytesType)
+	b = protowire.AppendString(b, record.Key)
+	b = protowire.AppendTag(b, walIdempotencyFingerprint, protowire.BytesType)
+	b = protowire.AppendBytes(b, record.Fingerprint)
+	b = protowire.AppendTag(b, walIdempotencyResponse, protowire.BytesType)
+	b = protowire.AppendBytes(b, record.Response)
+	b = protowire.AppendTag(b, walIdempotencyExpires, protowire.VarintType)
+	return protowire.AppendVarint(b, uint64(record.Expires.UnixNano()))
+}
+
+func consumeIdempotencyRecord(b []byte) (*IdempotencyRecord, error) {
+	record := &IdempotencyRecord{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, CorruptWALError
+		}
+		b = b[n:]
+		if num == walIdempotencyExpires && typ == protowire.VarintType {
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return nil, CorruptWALError
+			}
+			record.Expires = time.Unix(0, int64(v))
+			b = b[n:]
+			continue
+		}
+		if typ != protowire.BytesType {
+			return nil, CorruptWALError
+		}
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return nil, CorruptWALError
+		}
+		b = b[n:]
+		switch num {
+		case walIdempotencyKey:
+			record.Key = string(v)
+		case walIdempotencyFingerprint:
+			record.Fingerprint = append([]byte(nil), v...)
+		case walIdempotencyResponse:
+			record.Response = append([]byte(nil), v...)
+		}
+	}
+	return record, nil
+}
+
+func appendFrame(b []byte, payload []byte) []byte {
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(payload)))
+	b = binary.LittleEndian.AppendUint32(b, crc32.Checksum(payload, walCRCTable))
+	return append(b, payload...)
+}
+
+// readFrame returns the payload of the frame at the start of b and the
+// number of bytes it occupies, or 0 if the frame is incomplete or fails its
+// checksum.
+func readFrame(b []byte) ([]byte, int) {
+	if len(b) < walFrameHeaderSize {
+		return nil, 0
+	}
+	size := int(binary.LittleEndian.Uint32(b))
+	if len(b)-walFrameHeaderSize < size {
+		return nil, 0
+	}
+	payload := b[walFrameHeaderSize : walFrameHeaderSize+size]
+	if crc32.Checksum(payload, walCRCTable) != binary.LittleEndian.Uint32(b[4:]) {
+		return nil, 0
+	}
+	return payload, walFrameHeaderSize + size
+}
+
+// unexpiredIdempotencyRecords returns the records that a snapshot keeps.
+func unexpiredIdempotencyRecords(m map[string]*IdempotencyRecord) []*IdempotencyRecord {
+	now := time.Now()
+	records := make([]*IdempotencyRecord, 0, len(m))
+	for _, record := range m {
+		if !now.After(record.Expires) {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func mapValues[T any](m map[string]T) []T {
+	values := make([]T, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
+
+func writeFileSync(path string, data []byte) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
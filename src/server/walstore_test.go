@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestWALStore(t *testing.T, dir string) *WALStore {
+	w := NewWALStore(dir)
+	w.SnapshotInterval = 0
+	require.NoError(t, w.Open())
+	return w
+}
+
+func seedWALStore(t *testing.T, w *WALStore) {
+	require.NoError(t, w.CreateAccount(context.Background(), &banking.Account{Id: "a", Balance: usd(100)}))
+	require.NoError(t, w.CreateAccount(context.Background(), &banking.Account{Id: "b", Balance: usd(100)}))
+	require.NoError(t, w.Transfer(context.Background(), &banking.Transaction{TransactionId: "t1", FromAccountId: "a", ToAccountId: "b", Amount: usd(30)}))
+}
+
+func assertSeededState(t *testing.T, w *WALStore) {
+	a, err := w.GetAccount(context.Background(), "a")
+	require.NoError(t, err)
+	b, err := w.GetAccount(context.Background(), "b")
+	require.NoError(t, err)
+	assert.Equal(t, int64(70), a.Balance.Units)
+	assert.Equal(t, int64(130), b.Balance.Units)
+	tx, err := w.GetTransaction(context.Background(), "t1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), tx.Amount.Units)
+}
+
+func TestWALStore_RecoversFromLog(t *testing.T) {
+	dir := t.TempDir()
+	w := openTestWALStore(t, dir)
+	seedWALStore(t, w)
+	// Simulate a crash: drop the store without a final snapshot.
+	w.log.Close()
+
+	w = openTestWALStore(t, dir)
+	assertSeededState(t, w)
+	var ids []string
+	require.NoError(t, w.ScanAccounts(context.Background(), accountsByID, nil, func(account *banking.Account) bool {
+		ids = append(ids, account.Id)
+		return true
+	}))
+	assert.Equal(t, []string{"a", "b"}, ids, "indexes are rebuilt after replay")
+	assert.NoError(t, w.Close())
+}
+
+func TestWALStore_SnapshotCompactsLog(t *testing.T) {
+	dir := t.TempDir()
+	w := openTestWALStore(t, dir)
+	seedWALStore(t, w)
+	require.NoError(t, w.Snapshot())
+
+	segments, err := w.segments()
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1}, segments)
+
+	require.NoError(t, w.Transfer(context.Background(), &banking.Transaction{TransactionId: "t2", FromAccountId: "b", ToAccountId: "a", Amount: usd(10)}))
+	w.log.Close()
+
+	w = openTestWALStore(t, dir)
+	a, _ := w.GetAccount(context.Background(), "a")
+	assert.Equal(t, int64(80), a.Balance.Units)
+	_, err = w.GetTransaction(context.Background(), "t1")
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+}
+
+func TestWALStore_TruncatesTornWrite(t *testing.T) {
+	dir := t.TempDir()
+	w := openTestWALStore(t, dir)
+	seedWALStore(t, w)
+	w.log.Close()
+
+	path := filepath.Join(dir, "wal-0000000000000000.log")
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0xff, 0x00, 0x00})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	w = openTestWALStore(t, dir)
+	assertSeededState(t, w)
+	require.NoError(t, w.CreateAccount(context.Background(), &banking.Account{Id: "c", Balance: usd(5)}))
+	w.log.Close()
+
+	w = openTestWALStore(t, dir)
+	c, err := w.GetAccount(context.Background(), "c")
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), c.Balance.Units)
+	assert.NoError(t, w.Close())
+}
+
+// faultyFile fails the next write, after writing part of it, or the next
+// sync of the log segment it wraps, and every truncate if told to.
+type faultyFile struct {
+	walFile
+	failWrite, failSync, failTruncate bool
+}
+
+var injectedFault = errors.New("injected fault")
+
+func (f *faultyFile) Write(p []byte) (int, error) {
+	if f.failWrite {
+		f.failWrite = false
+		n, _ := f.walFile.Write(p[:len(p)/2])
+		return n, injectedFault
+	}
+	return f.walFile.Write(p)
+}
+
+func (f *faultyFile) Sync() error {
+	if f.failSync {
+		f.failSync = false
+		return injectedFault
+	}
+	return f.walFile.Sync()
+}
+
+func (f *faultyFile) Truncate(size int64) error {
+	if f.failTruncate {
+		return injectedFault
+	}
+	return f.walFile.Truncate(size)
+}
+
+func TestWALStore_FailedAppendIsTruncated(t *testing.T) {
+	for _, fault := range []*faultyFile{{failWrite: true}, {failSync: true}} {
+		dir := t.TempDir()
+		w := openTestWALStore(t, dir)
+		seedWALStore(t, w)
+		fault.walFile = w.log
+		w.log = fault
+
+		err := w.CreateAccount(context.Background(), &banking.Account{Id: "c", Balance: usd(5)})
+		require.ErrorIs(t, err, injectedFault)
+		require.NoError(t, w.CreateAccount(context.Background(), &banking.Account{Id: "d", Balance: usd(5)}))
+		w.log.Close()
+
+		w = openTestWALStore(t, dir)
+		assertSeededState(t, w)
+		_, err = w.GetAccount(context.Background(), "c")
+		assert.ErrorIs(t, err, AccountNotFoundError, "failed append is not replayed")
+		_, err = w.GetAccount(context.Background(), "d")
+		assert.NoError(t, err, "append after the failed one survives")
+		assert.NoError(t, w.Close())
+	}
+}
+
+func TestWALStore_FailsWhenTruncateFails(t *testing.T) {
+	dir := t.TempDir()
+	w := openTestWALStore(t, dir)
+	seedWALStore(t, w)
+	w.log = &faultyFile{walFile: w.log, failWrite: true, failTruncate: true}
+
+	err := w.CreateAccount(context.Background(), &banking.Account{Id: "c", Balance: usd(5)})
+	require.ErrorIs(t, err, injectedFault)
+	err = w.CreateAccount(context.Background(), &banking.Account{Id: "d", Balance: usd(5)})
+	assert.ErrorIs(t, err, WALFailedError)
+	assert.ErrorIs(t, w.Snapshot(), WALFailedError)
+	assertSeededState(t, w)
+	w.log.Close()
+
+	w = openTestWALStore(t, dir)
+	assertSeededState(t, w)
+	require.NoError(t, w.CreateAccount(context.Background(), &banking.Account{Id: "d", Balance: usd(5)}))
+	assert.NoError(t, w.Close())
+}
+
+func TestWALStore_RecoversJournalEntries(t *testing.T) {
+	dir := t.TempDir()
+	w := openTestWALStore(t, dir)
+	seedWALStore(t, w)
+	require.NoError(t, w.PostJournalEntry(context.Background(), &banking.JournalEntry{
+		EntryId: "e1",
+		Legs: []*banking.JournalLeg{
+			{AccountId: "a", Amount: usd(-20)},
+			{AccountId: "b", Amount: usd(20)},
+		},
+	}))
+	w.log.Close()
+
+	w = openTestWALStore(t, dir)
+	a, _ := w.GetAccount(context.Background(), "a")
+	assert.Equal(t, int64(50), a.Balance.Units)
+	assert.Contains(t, w.journalEntries, "e1")
+	require.NoError(t, w.Snapshot())
+	w.log.Close()
+
+	w = openTestWALStore(t, dir)
+	assert.Contains(t, w.journalEntries, "e1")
+	assert.NoError(t, w.Close())
+}
+
+func TestWALStore_RecoversSchedules(t *testing.T) {
+	dir := t.TempDir()
+	w := openTestWALStore(t, dir)
+	seedWALStore(t, w)
+	require.NoError(t, w.CreateSchedule(context.Background(), &banking.Schedule{
+		ScheduleId: "s1",
+		Recurrence: "@monthly",
+		Status:     banking.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+	}))
+	_, err := w.UpdateSchedule(context.Background(), "s1", func(schedule *banking.Schedule) error {
+		schedule.RunCount = 3
+		return nil
+	})
+	require.NoError(t, err)
+	w.log.Close()
+
+	w = openTestWALStore(t, dir)
+	schedule, err := w.GetSchedule(context.Background(), "s1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), schedule.RunCount)
+	require.NoError(t, w.Snapshot())
+	w.log.Close()
+
+	w = openTestWALStore(t, dir)
+	_, err = w.GetSchedule(context.Background(), "s1")
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+}
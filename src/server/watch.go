@@ -0,0 +1,124 @@
+package server
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// watchHistorySize is the minimum number of recent events retained so
+	// that watchers can resume after reconnecting.
+	watchHistorySize = 4096
+	// watchBufferSize is how many events may queue up for one watcher before
+	// it is disconnected.
+	watchBufferSize = 256
+)
+
+var WatchLaggedError = errors.New("Watcher fell too far behind; resume from the last event received")
+var SequenceExpiredError = errors.New("Events after the requested sequence are no longer retained")
+
+// eventHub fans balance changes out to WatchAccount streams. Publishing
+// never blocks: a watcher whose buffer fills up is dropped, and may resume
+// from the last sequence number it received.
+type eventHub struct {
+	mtx         sync.Mutex
+	seq         uint64
+	history     []*banking.AccountEvent
+	subscribers map[*subscription]struct{}
+}
+
+// subscription is one watcher's queue of events.
+type subscription struct {
+	accounts map[string]bool
+	events   chan *banking.AccountEvent
+	// lagged is closed when the subscription is dropped for falling behind.
+	lagged chan struct{}
+}
+
+func newEventHub() *eventHub {
+	return &eventHub{subscribers: make(map[*subscription]struct{})}
+}
+
+// publish records an event for every account changed by cause, a
+// transaction or journal entry. It is called by the store in commit order,
+// so it must not block.
+func (h *eventHub) publish(cause proto.Message, accounts []*banking.Account) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	for _, account := range accounts {
+		h.seq++
+		event := &banking.AccountEvent{
+			Sequence:  h.seq,
+			AccountId: account.Id,
+			Balance:   proto.Clone(account.Balance).(*banking.Money),
+		}
+		switch cause := proto.Clone(cause).(type) {
+		case *banking.Transaction:
+			event.Transaction = cause
+		case *banking.JournalEntry:
+			event.JournalEntry = cause
+		}
+		h.history = append(h.history, event)
+		if len(h.history) >= 2*watchHistorySize {
+			h.history = append(h.history[:0], h.history[len(h.history)-watchHistorySize:]...)
+		}
+
+		for sub := range h.subscribers {
+			if !sub.accounts[account.Id] {
+				continue
+			}
+			select {
+			case sub.events <- event:
+			default:
+				close(sub.lagged)
+				delete(h.subscribers, sub)
+			}
+		}
+	}
+}
+
+// subscribe registers a watcher of accountIDs and returns the retained
+// events after afterSequence that it missed. It returns SequenceExpiredError
+// if some of those events are no longer retained.
+func (h *eventHub) subscribe(accountIDs []string, afterSequence uint64) (*subscription, []*banking.AccountEvent, error) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	sub := &subscription{
+		accounts: make(map[string]bool, len(accountIDs)),
+		events:   make(chan *banking.AccountEvent, watchBufferSize),
+		lagged:   make(chan struct{}),
+	}
+	for _, id := range accountIDs {
+		sub.accounts[id] = true
+	}
+
+	var backlog []*banking.AccountEvent
+	if afterSequence > 0 {
+		oldest := h.seq + 1
+		if len(h.history) > 0 {
+			oldest = h.history[0].Sequence
+		}
+		if afterSequence > h.seq || afterSequence+1 < oldest {
+			return nil, nil, SequenceExpiredError
+		}
+		for _, event := range h.history {
+			if event.Sequence > afterSequence && sub.accounts[event.AccountId] {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+	h.subscribers[sub] = struct{}{}
+	return sub, backlog, nil
+}
+
+func (h *eventHub) unsubscribe(sub *subscription) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	delete(h.subscribers, sub)
+}
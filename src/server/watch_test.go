@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bryanvaz/grpc-gl/protos/go/banking"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *banking.AccountEvent
+}
+
+func (f *fakeWatchStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeWatchStream) Send(event *banking.AccountEvent) error {
+	f.events <- event
+	return nil
+}
+
+// watch starts a WatchAccount call and returns its stream, a function that
+// cancels it, and a channel carrying its result.
+func watch(s *Server, req *banking.WatchAccountRequest) (*fakeWatchStream, context.CancelFunc, chan error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeWatchStream{ctx: ctx, events: make(chan *banking.AccountEvent, 16)}
+	done := make(chan error, 1)
+	go func() { done <- s.WatchAccount(req, stream) }()
+	return stream, cancel, done
+}
+
+func receive(t *testing.T, stream *fakeWatchStream) *banking.AccountEvent {
+	select {
+	case event := <-stream.events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+		return nil
+	}
+}
+
+func TestWatchAccount_LiveAndResume(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100, 100)
+
+	stream, cancel, done := watch(s, &banking.WatchAccountRequest{AccountIds: []string{accounts[1]}})
+	// Wait for the watcher to subscribe before transferring.
+	require.Eventually(t, func() bool {
+		s.events.mtx.Lock()
+		defer s.events.mtx.Unlock()
+		return len(s.events.subscribers) == 1
+	}, time.Second, time.Millisecond)
+
+	ids := makeTestTransfers(t, s, accounts[0], accounts[1], 10, 20)
+	first := receive(t, stream)
+	assert.Equal(t, accounts[1], first.AccountId)
+	assertProtoEqual(t, usd(110), first.Balance)
+	assert.Equal(t, ids[0], first.Transaction.TransactionId)
+	second := receive(t, stream)
+	assert.Greater(t, second.Sequence, first.Sequence)
+	assertProtoEqual(t, usd(130), second.Balance)
+
+	cancel()
+	assert.Equal(t, codes.Canceled, status.Code(<-done))
+
+	// Resuming replays only the events that were missed.
+	stream, cancel, done = watch(s, &banking.WatchAccountRequest{
+		AccountIds:    []string{accounts[1]},
+		AfterSequence: first.Sequence,
+	})
+	resumed := receive(t, stream)
+	assertProtoEqual(t, second, resumed)
+	cancel()
+	<-done
+}
+
+func TestWatchAccount_InvalidRequests(t *testing.T) {
+	s := getNewTestServer()
+	accounts := createTestAccounts(t, s, 100)
+
+	_, cancel, done := watch(s, &banking.WatchAccountRequest{AccountIds: []string{uuid.NewString()}})
+	defer cancel()
+	assert.Equal(t, codes.NotFound, status.Code(<-done))
+
+	_, cancel, done = watch(s, &banking.WatchAccountRequest{AccountIds: accounts, AfterSequence: 5})
+	defer cancel()
+	assert.Equal(t, codes.OutOfRange, status.Code(<-done))
+}
+
+func TestEventHub_SequenceExpired(t *testing.T) {
+	h := newEventHub()
+	account := &banking.Account{Id: "a", Balance: usd(1)}
+	for i := 0; i < 2*watchHistorySize; i++ {
+		h.publish(&banking.Transaction{}, []*banking.Account{account})
+	}
+
+	_, _, err := h.subscribe([]string{"a"}, 1)
+	assert.ErrorIs(t, err, SequenceExpiredError)
+
+	_, backlog, err := h.subscribe([]string{"a"}, h.seq-1)
+	require.NoError(t, err)
+	require.Len(t, backlog, 1)
+	assert.Equal(t, h.seq, backlog[0].Sequence)
+}
+
+func TestEventHub_DropsLaggingSubscriber(t *testing.T) {
+	h := newEventHub()
+	slow, _, err := h.subscribe([]string{"a"}, 0)
+	require.NoError(t, err)
+	other, _, err := h.subscribe([]string{"b"}, 0)
+	require.NoError(t, err)
+
+	account := &banking.Account{Id: "a", Balance: usd(1)}
+	for i := 0; i <= watchBufferSize; i++ {
+		h.publish(&banking.Transaction{}, []*banking.Account{account})
+	}
+
+	select {
+	case <-slow.lagged:
+	default:
+		t.Fatal("lagging subscriber was not dropped")
+	}
+	assert.Len(t, slow.events, watchBufferSize)
+	assert.NotContains(t, h.subscribers, slow)
+	assert.Contains(t, h.subscribers, other)
+}
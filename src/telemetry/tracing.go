@@ -0,0 +1,103 @@
+// Package telemetry sets up the tracing shared by the banking server and
+// client.
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Exporters are the accepted values of TracingConfig.Exporter.
+var Exporters = []string{"none", "otlp", "stdout", "file"}
+
+// TracingConfig chooses where spans are sent; see SetupTracing.
+type TracingConfig struct {
+	// Exporter is none, otlp, stdout or file.
+	Exporter string `yaml:"exporter"`
+	// Endpoint is the URL of the OTLP/gRPC collector the otlp exporter
+	// sends spans to, in plaintext if it is an http URL.
+	Endpoint string `yaml:"endpoint"`
+	// File is where the file exporter appends spans, as JSON.
+	File string `yaml:"file"`
+	// SampleRatio is the fraction of traces started here that are
+	// recorded. Traces started by a caller follow its decision.
+	SampleRatio float64 `yaml:"sampleRatio"`
+}
+
+// SetupTracing installs a global tracer provider exporting the spans of
+// service as cfg says, and propagates trace context in W3C traceparent
+// headers. The returned func flushes any spans not yet exported and
+// closes the exporter.
+func SetupTracing(ctx context.Context, service string, cfg TracingConfig) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if cfg.Exporter == "" || cfg.Exporter == "none" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var exporter sdktrace.SpanExporter
+	var closer io.Closer
+	switch cfg.Exporter {
+	case "otlp":
+		u, err := url.Parse(cfg.Endpoint)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("bad OTLP endpoint %q", cfg.Endpoint)
+		}
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(u.Host)}
+		if u.Scheme == "http" {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		if exporter, err = otlptracegrpc.New(ctx, opts...); err != nil {
+			return nil, err
+		}
+	case "stdout":
+		var err error
+		if exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout)); err != nil {
+			return nil, err
+		}
+	case "file":
+		f, err := os.OpenFile(cfg.File, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		if exporter, err = stdouttrace.New(stdouttrace.WithWriter(f)); err != nil {
+			return nil, errors.Join(err, f.Close())
+		}
+		closer = f
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", cfg.Exporter)
+	}
+
+	res, err := resource.Merge(resource.Default(),
+		resource.NewSchemaless(attribute.String("service.name", service)))
+	if err != nil {
+		if closer != nil {
+			err = errors.Join(err, closer.Close())
+		}
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closer != nil {
+			err = errors.Join(err, closer.Close())
+		}
+		return err
+	}, nil
+}
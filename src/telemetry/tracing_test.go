@@ -0,0 +1,42 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+)
+
+func TestSetupTracing_File(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "spans.json")
+	shutdown, err := SetupTracing(context.Background(), "banking-test", TracingConfig{Exporter: "file", File: file, SampleRatio: 1})
+	require.NoError(t, err)
+	_, span := otel.Tracer("test").Start(context.Background(), "work")
+	span.End()
+	require.NoError(t, shutdown(context.Background()))
+
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	type attribute struct {
+		Key   string
+		Value struct{ Value any }
+	}
+	var exported struct {
+		Name     string
+		Resource []attribute
+	}
+	require.NoError(t, json.NewDecoder(bytes.NewReader(data)).Decode(&exported))
+	assert.Equal(t, "work", exported.Name)
+	service := attribute{Key: "service.name"}
+	service.Value.Value = "banking-test"
+	assert.Contains(t, exported.Resource, service)
+
+	_, err = SetupTracing(context.Background(), "banking-test", TracingConfig{Exporter: "file", File: filepath.Join(file, "missing", "spans.json")})
+	assert.Error(t, err)
+}